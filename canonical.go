@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"slices"
+)
+
+// A shape is a set of hexes compared without regard to where it is on the
+// map. Two shapes are the same when one can be moved onto the other; with
+// CanonicalShape, they are also the same when one can be rotated or
+// reflected onto the other.
+
+// NormalizeShape returns the hexes moved so that the first hex, sorted by r
+// and then by q, is at the origin. The result is sorted and has no duplicates,
+// so two shapes that differ only by position have the same normal form.
+func NormalizeShape(hexes []Hex) []Hex {
+	shape := slices.Clone(hexes)
+	slices.SortFunc(shape, compareHexes)
+	shape = slices.Compact(shape)
+	if len(shape) == 0 {
+		return shape
+	}
+	origin := shape[0]
+	for i, h := range shape {
+		shape[i] = h.Subtract(origin)
+	}
+	return shape
+}
+
+// CanonicalShape returns the canonical form of the hexes under translation
+// and the 12 symmetries of the grid, along with the transform that turns the
+// hexes into it. The canonical form is the normal form of the transformed
+// hexes that sorts first; if more than one transform gives it, the first in
+// the order of Transforms is returned.
+func CanonicalShape(hexes []Hex) (shape []Hex, transform Transform) {
+	return canonicalShape(hexes, Transforms())
+}
+
+// canonicalShape returns the first normal form of the hexes under the transforms.
+func canonicalShape(hexes []Hex, transforms []Transform) (shape []Hex, transform Transform) {
+	image := make([]Hex, len(hexes))
+	for n, t := range transforms {
+		for i, h := range hexes {
+			image[i] = t.Apply(h)
+		}
+		candidate := NormalizeShape(image)
+		if n == 0 || slices.CompareFunc(candidate, shape, compareHexes) < 0 {
+			shape, transform = candidate, t
+		}
+	}
+	return shape, transform
+}
+
+// ShapeHash returns a hash of the canonical form of the hexes.
+// Shapes that are the same under translation, rotation and reflection have
+// the same hash. The hash doesn't depend on the order of the hexes and is
+// stable from one run, or one machine, to the next.
+func ShapeHash(hexes []Hex) uint64 {
+	shape, _ := CanonicalShape(hexes)
+	return hashShape(shape)
+}
+
+// hashShape returns the FNV-1a hash of the axial coordinates of the hexes.
+func hashShape(shape []Hex) uint64 {
+	hash := fnv.New64a()
+	var buf [16]byte
+	for _, h := range shape {
+		binary.LittleEndian.PutUint64(buf[0:], uint64(h.q))
+		binary.LittleEndian.PutUint64(buf[8:], uint64(h.r))
+		hash.Write(buf[:])
+	}
+	return hash.Sum64()
+}
+
+// Symmetries returns the transforms that map the shape onto itself after
+// moving it back into place, in the order of Transforms.
+// The identity is always included, so a shape with no symmetry returns one
+// transform and a hexagon returns all 12.
+func Symmetries(hexes []Hex) []Transform {
+	shape := NormalizeShape(hexes)
+	image := make([]Hex, len(shape))
+	var symmetries []Transform
+	for _, t := range Transforms() {
+		for i, h := range shape {
+			image[i] = t.Apply(h)
+		}
+		if slices.Equal(NormalizeShape(image), shape) {
+			symmetries = append(symmetries, t)
+		}
+	}
+	return symmetries
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestNormalizeShape(t *testing.T) {
+	shape := []hexg.Hex{hexg.NewHex(2, 0, -2), hexg.NewHex(1, 1, -2), hexg.NewHex(2, 1, -3), hexg.NewHex(2, 0, -2)}
+	offset := hexg.NewHex(-7, 3, 4)
+	var moved []hexg.Hex
+	for _, h := range shape {
+		moved = append(moved, h.Add(offset))
+	}
+	got, want := hexg.NormalizeShape(moved), []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(-1, 1, 0), hexg.NewHex(0, 1, -1)}
+	if !slices.Equal(got, want) {
+		t.Errorf("normalize: got %v, want %v\n", got, want)
+	}
+}
+
+func TestCanonicalShape(t *testing.T) {
+	// an asymmetric shape of four hexes
+	shape := []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(1, -1, 0), hexg.NewHex(3, -2, -1)}
+	want, _ := hexg.CanonicalShape(shape)
+	wantHash := hexg.ShapeHash(shape)
+	for _, tr := range hexg.Transforms() {
+		var image []hexg.Hex
+		for _, h := range shape {
+			image = append(image, tr.Apply(h).Add(hexg.NewHex(5, -9, 4)))
+		}
+		slices.Reverse(image)
+		got, transform := hexg.CanonicalShape(image)
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v\n", tr, got, want)
+		}
+		if got := hexg.ShapeHash(image); got != wantHash {
+			t.Errorf("%s: hash: got %x, want %x\n", tr, got, wantHash)
+		}
+		// the transform turns the hexes into the canonical form
+		var transformed []hexg.Hex
+		for _, h := range image {
+			transformed = append(transformed, transform.Apply(h))
+		}
+		if got := hexg.NormalizeShape(transformed); !slices.Equal(got, want) {
+			t.Errorf("%s: transform %s: got %v, want %v\n", tr, transform, got, want)
+		}
+	}
+
+	// a bent line and a straight line are different shapes
+	bent := []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(1, 1, -2)}
+	straight := []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(2, 0, -2)}
+	if hexg.ShapeHash(bent) == hexg.ShapeHash(straight) {
+		t.Errorf("hash: bent and straight: got equal, want different\n")
+	}
+
+	// the hash is stable from one run to the next
+	if got, want := hexg.ShapeHash([]hexg.Hex{hexg.NewHex(4, 4, -8)}), uint64(0x88201fb960ff6465); got != want {
+		t.Errorf("hash: single hex: got %#x, want %#x\n", got, want)
+	}
+}
+
+func TestSymmetries(t *testing.T) {
+	for _, tc := range []struct {
+		id    int
+		shape []hexg.Hex
+		want  int
+	}{
+		{1, []hexg.Hex{hexg.NewHex(3, -1, -2)}, 12},
+		{2, []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1)}, 4},
+		{3, []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(2, 0, -2)}, 4},
+		{4, []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(1, -1, 0)}, 6},
+		{5, []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(1, 1, -2)}, 2},
+		{6, []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(1, -1, 0), hexg.NewHex(3, -2, -1)}, 1},
+		{7, hexg.HexagonalGrid(2).Hexes(), 12},
+	} {
+		got := hexg.Symmetries(tc.shape)
+		if len(got) != tc.want {
+			t.Errorf("%d: got %v, want %d transforms\n", tc.id, got, tc.want)
+		}
+		if len(got) > 0 && got[0] != (hexg.Transform{}) {
+			t.Errorf("%d: first: got %s, want the identity\n", tc.id, got[0])
+		}
+	}
+}
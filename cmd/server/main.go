@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/maloquacious/hexg"
+	"github.com/maloquacious/hexg/svg"
 	"github.com/spf13/cobra"
 )
 
@@ -95,6 +96,7 @@ func startServer() {
 	mux.HandleFunc("/", handleHome)
 	mux.HandleFunc("/status", handleStatus)
 	mux.HandleFunc("/corners", handleCorners)
+	mux.HandleFunc("/map.svg", handleMapSVG)
 	mux.HandleFunc("POST /neighbors", handleNeighbors)
 
 	// API routes
@@ -217,6 +219,30 @@ func handleCorners(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func handleMapSVG(w http.ResponseWriter, r *http.Request) {
+	// Parse parameters, keeping the map small enough to render quickly
+	cols, _ := strconv.Atoi(r.URL.Query().Get("cols"))
+	rows, _ := strconv.Atoi(r.URL.Query().Get("rows"))
+	if cols < 1 || cols > 60 {
+		cols = 8
+	}
+	if rows < 1 || rows > 60 {
+		rows = 6
+	}
+
+	l := hexg.NewTribeNetLayout()
+	renderer := svg.New(l, svg.Options{
+		Label:     svg.SchemeLabels(l.LabelScheme()),
+		GridLines: true,
+		Margin:    0.1,
+	})
+
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if err := renderer.Render(w, l.OffsetRect(0, cols-1, 0, rows-1)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 func handleNeighbors(w http.ResponseWriter, r *http.Request) {
 	log.Printf("%s %s: entered\n", r.Method, r.URL)
 	if r.Method != http.MethodPost {
@@ -244,17 +270,14 @@ func handleNeighbors(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert TribeNet coordinates to OffsetCoord
-	offsetCoord, err := hexg.NewTribeNetOffsetCoord(tnCoords)
+	// Convert TribeNet coordinates to Hex (cube coordinates).
+	// Use the error-returning conversion so that bad input can't panic the handler.
+	centerHex, err := hexg.NewTribeNetLayout().TribeNetCoordToHex(tnCoords)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Invalid TribeNet coordinates: %v", err), http.StatusBadRequest)
 		return
 	}
-	log.Printf("[neighbors] tn %q: oc %+v\n", tnCoords, offsetCoord)
-
-	// Convert to Hex (cube coordinates)
-	centerHex := offsetCoord.ToCubeOdd()
-	log.Printf("[neighbors] oc %+v: ch %+v\n", offsetCoord, centerHex)
+	log.Printf("[neighbors] tn %q: ch %+v\n", tnCoords, centerHex)
 
 	// Get neighbors
 	neighbors := getNeighborsForHex(centerHex)
@@ -319,7 +342,7 @@ func getHexagonCorners() []CornerInfo {
 }
 
 func getHexagonCornersWithParams(sizeX, sizeY, originX, originY float64) []CornerInfo {
-	l := hexg.NewLayoutFlat(hexg.NewPoint(sizeX, sizeY), hexg.NewPoint(originX, originY), false)
+	l := hexg.NewLayoutFlat(hexg.NewPoint(sizeX, sizeY), hexg.NewPoint(originX, originY))
 	var corners []CornerInfo
 
 	for corner, point := range l.PolygonCorners() {
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Heading is the direction from one hex to another in a layout.
+type Heading struct {
+	// Distance is the number of steps between the hexes.
+	// If it is zero, the hexes are the same and the other fields are zero values.
+	Distance int
+
+	// Direction is the nearest of the six directions, 0..5.
+	// When the heading is exactly between two directions, as it is for
+	// diagonals, the lower-numbered direction wins.
+	Direction int
+
+	// Diagonal is the nearest of the six diagonal directions, 0..5.
+	// Diagonal k lies between directions k and k+1.
+	Diagonal int
+
+	// IsDiagonal is true if the diagonal is nearer than the direction.
+	IsDiagonal bool
+
+	// Angle is the exact angle in the layout's pixel space.
+	// It is in compass degrees: 0 is up on the screen and angles increase clockwise.
+	Angle float64
+
+	// Bearing is the layout's name for Direction (see Layout_i.DirectionToBearing).
+	Bearing string
+
+	// Compass is the name of the nearest of the twelve compass points,
+	// which is the nearest direction or diagonal.
+	Compass string
+}
+
+// compassPoints are the twelve compass points from the table in hexg.go, 30° apart.
+var compassPoints = [12]string{
+	"N", "NNE", "ENE", "E", "ESE", "SSE",
+	"S", "SSW", "WSW", "W", "WNW", "NNW",
+}
+
+// DirectionTo returns the heading from the hex to b in the layout.
+func (h Hex) DirectionTo(l Layout_i, b Hex) Heading {
+	distance := h.Distance(b)
+	if distance == 0 {
+		return Heading{}
+	}
+	heading := Heading{
+		Distance: distance,
+		Angle:    compassAngle(l, b.Subtract(h)),
+	}
+
+	// find the nearest direction and diagonal by the difference in angles.
+	// the angles of the directions come from the layout so that
+	// non-uniform sizes are handled.
+	bestDirection, bestDiagonal := math.Inf(1), math.Inf(1)
+	for k := 0; k < 6; k++ {
+		if delta := angleBetween(heading.Angle, compassAngle(l, Direction(k))); delta < bestDirection-1e-9 {
+			heading.Direction, bestDirection = k, delta
+		}
+		if delta := angleBetween(heading.Angle, compassAngle(l, Diagonal(k))); delta < bestDiagonal-1e-9 {
+			heading.Diagonal, bestDiagonal = k, delta
+		}
+	}
+	heading.IsDiagonal = bestDiagonal < bestDirection
+	heading.Bearing = l.DirectionToBearing(heading.Direction)
+	if heading.IsDiagonal {
+		heading.Compass = compassName(l, Diagonal(heading.Diagonal))
+	} else {
+		heading.Compass = compassName(l, Direction(heading.Direction))
+	}
+
+	return heading
+}
+
+// Move returns the hex that is n steps from the hex along the bearing.
+//
+// The bearing may be any name returned by the layout's DirectionToBearing,
+// which moves to the neighbor in that direction, or the compass name of a
+// diagonal in the layout (for example, "E" on a flat-top layout), which moves
+// to the diagonal neighbor. The bearing is not case-sensitive.
+// Returns ErrInvalidBearing for other bearings.
+func (h Hex) Move(l Layout_i, bearing string, n int) (Hex, error) {
+	step, ok := bearingStep(l, bearing)
+	if !ok {
+		return h, fmt.Errorf("%q: %w", bearing, ErrInvalidBearing)
+	}
+	return h.Add(step.Multiply(n)), nil
+}
+
+// bearingStep returns the offset for one step along the bearing in the layout.
+func bearingStep(l Layout_i, bearing string) (Hex, bool) {
+	bearing = strings.ToUpper(strings.TrimSpace(bearing))
+	for k := 0; k < 6; k++ {
+		if l.DirectionToBearing(k) == bearing {
+			return Direction(k), true
+		}
+	}
+	for k := 0; k < 6; k++ {
+		if compassName(l, Diagonal(k)) == bearing {
+			return Diagonal(k), true
+		}
+	}
+	return Hex{}, false
+}
+
+// compassAngle returns the angle of the offset in the layout's pixel space,
+// in compass degrees (0 is up on the screen, increasing clockwise).
+func compassAngle(l Layout_i, offset Hex) float64 {
+	from, to := l.HexToPixel(Hex{}), l.HexToPixel(offset)
+	// screen y points down, so up on the screen is -y.
+	angle := math.Atan2(to.X-from.X, -(to.Y-from.Y)) * 180 / math.Pi
+	if angle < 0 {
+		angle += 360
+	}
+	return angle
+}
+
+// compassName returns the name of the compass point nearest to the offset.
+func compassName(l Layout_i, offset Hex) string {
+	return compassPoints[int(math.Round(compassAngle(l, offset)/30))%12]
+}
+
+// angleBetween returns the smallest difference between two angles in degrees.
+func angleBetween(a, b float64) float64 {
+	delta := math.Mod(math.Abs(a-b), 360)
+	if delta > 180 {
+		delta = 360 - delta
+	}
+	return delta
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestHex_DirectionTo(t *testing.T) {
+	l := hexg.NewVerticalOddQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	from := hexg.NewHex(0, 0, 0)
+
+	for _, tc := range []struct {
+		id         int
+		to         hexg.Hex
+		direction  int
+		isDiagonal bool
+		angle      float64
+		bearing    string
+		compass    string
+	}{
+		{id: 1, to: hexg.NewHex(0, -2, 2), direction: hexg.N, angle: 0, bearing: "N", compass: "N"},
+		{id: 2, to: hexg.NewHex(0, 3, -3), direction: hexg.S, angle: 180, bearing: "S", compass: "S"},
+		{id: 3, to: hexg.NewHex(1, 0, -1), direction: hexg.ESE, angle: 120, bearing: "ESE", compass: "ESE"},
+		{id: 4, to: hexg.NewHex(2, -1, -1), direction: hexg.ESE, isDiagonal: true, angle: 90, bearing: "ESE", compass: "E"},
+		{id: 5, to: hexg.NewHex(1, -2, 1), direction: hexg.ENE, isDiagonal: true, angle: 30, bearing: "ENE", compass: "NNE"},
+		{id: 6, to: hexg.NewHex(-4, 2, 2), direction: hexg.WNW, isDiagonal: true, angle: 270, bearing: "WNW", compass: "W"},
+	} {
+		got := from.DirectionTo(l, tc.to)
+		if got.Distance != from.Distance(tc.to) {
+			t.Errorf("%d: distance: got %d, want %d\n", tc.id, got.Distance, from.Distance(tc.to))
+		}
+		if got.Direction != tc.direction {
+			t.Errorf("%d: direction: got %d, want %d\n", tc.id, got.Direction, tc.direction)
+		}
+		if got.IsDiagonal != tc.isDiagonal {
+			t.Errorf("%d: diagonal: got %v, want %v\n", tc.id, got.IsDiagonal, tc.isDiagonal)
+		}
+		if math.Abs(got.Angle-tc.angle) > 1e-9 {
+			t.Errorf("%d: angle: got %g, want %g\n", tc.id, got.Angle, tc.angle)
+		}
+		if got.Bearing != tc.bearing {
+			t.Errorf("%d: bearing: got %q, want %q\n", tc.id, got.Bearing, tc.bearing)
+		}
+		if got.Compass != tc.compass {
+			t.Errorf("%d: compass: got %q, want %q\n", tc.id, got.Compass, tc.compass)
+		}
+	}
+
+	if got := from.DirectionTo(l, from); got != (hexg.Heading{}) {
+		t.Errorf("same hex: got %+v, want zero heading\n", got)
+	}
+}
+
+func TestHex_Move(t *testing.T) {
+	for _, l := range []hexg.Layout_i{
+		hexg.NewVerticalEvenQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0)),
+		hexg.NewTribeNetLayout(),
+	} {
+		from := hexg.NewHex(2, -3, 1)
+		for direction := 0; direction < 6; direction++ {
+			bearing := l.DirectionToBearing(direction)
+			for n := 1; n <= 3; n++ {
+				to, err := from.Move(l, bearing, n)
+				if err != nil {
+					t.Errorf("%s: move %d %q: error %v\n", l.OffsetType(), n, bearing, err)
+					continue
+				}
+				heading := from.DirectionTo(l, to)
+				if heading.Direction != direction || heading.Distance != n || heading.Bearing != bearing {
+					t.Errorf("%s: move %d %q: got %d %d %q\n", l.OffsetType(), n, bearing, heading.Distance, heading.Direction, heading.Bearing)
+				}
+			}
+		}
+	}
+
+	l := hexg.NewTribeNetLayout()
+	from := hexg.NewHex(0, 0, 0)
+	// "two hexes NNE" is a diagonal on a flat-top layout
+	if to, err := from.Move(l, "NNE", 2); err != nil {
+		t.Errorf("tn: move 2 NNE: error %v\n", err)
+	} else if got, want := to.ConciseString(), "+2-4+2"; got != want {
+		t.Errorf("tn: move 2 NNE: got %q, want %q\n", got, want)
+	}
+	if _, err := from.Move(l, "NNNE", 1); !errors.Is(err, hexg.ErrInvalidBearing) {
+		t.Errorf("tn: move NNNE: got %v, want %v\n", err, hexg.ErrInvalidBearing)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"fmt"
+	"math"
+)
+
+// Edges and vertices are topological; they don't depend on the layout.
+// The layout is only needed to convert them to pixels.
+//
+// Every edge is shared by two hexes and every vertex is shared by three
+// hexes, so both types are stored in a canonical form. That lets them be
+// compared with == and used as map keys.
+
+// Edge is a side of a hex.
+// It is the side shared by the hex and its neighbor in the given direction.
+type Edge struct {
+	hex       Hex
+	direction int // always 0, 1, or 2
+}
+
+// NewEdge returns the edge between the hex and its neighbor in the direction.
+// Direction is coerced to the range 0..5.
+func NewEdge(h Hex, direction int) Edge {
+	direction = (6 + (direction % 6)) % 6
+	if direction >= 3 {
+		return Edge{hex: h.Neighbor(direction), direction: direction - 3}
+	}
+	return Edge{hex: h, direction: direction}
+}
+
+// Hex returns the hex that the edge is stored with.
+func (e Edge) Hex() Hex {
+	return e.hex
+}
+
+// Direction returns the direction from Hex() to the other hex sharing the edge.
+// It is always 0, 1, or 2.
+func (e Edge) Direction() int {
+	return e.direction
+}
+
+// Hexes returns the two hexes that share the edge.
+func (e Edge) Hexes() [2]Hex {
+	return [2]Hex{e.hex, e.hex.Neighbor(e.direction)}
+}
+
+// Vertices returns the two vertices at the ends of the edge.
+func (e Edge) Vertices() [2]Vertex {
+	return [2]Vertex{NewVertex(e.hex, e.direction-1), NewVertex(e.hex, e.direction)}
+}
+
+// String implements the Stringer interface.
+// It returns the edge formatted as (q,r,s/direction).
+func (e Edge) String() string {
+	return fmt.Sprintf("%s/%d", e.hex, e.direction)
+}
+
+// Vertex is a corner of a hex.
+// Vertex k of a hex is the corner between the neighbors in direction k and k+1.
+type Vertex struct {
+	hex    Hex
+	corner int // always 0 or 1
+}
+
+// NewVertex returns the corner of the hex between the neighbors in
+// direction k and direction k+1. K is coerced to the range 0..5.
+func NewVertex(h Hex, k int) Vertex {
+	k = (6 + (k % 6)) % 6
+	// the same corner is k+2 of the neighbor in direction k
+	// and k+4 of the neighbor in direction k+1.
+	switch k {
+	case 0, 1:
+		return Vertex{hex: h, corner: k}
+	case 2, 3:
+		return Vertex{hex: h.Neighbor(k + 1), corner: k - 2}
+	}
+	return Vertex{hex: h.Neighbor(k), corner: k - 4}
+}
+
+// Hex returns the hex that the vertex is stored with.
+func (v Vertex) Hex() Hex {
+	return v.hex
+}
+
+// Corner returns the corner of Hex() that the vertex is at.
+// It is always 0 or 1.
+func (v Vertex) Corner() int {
+	return v.corner
+}
+
+// Hexes returns the three hexes that share the vertex.
+func (v Vertex) Hexes() [3]Hex {
+	return [3]Hex{v.hex, v.hex.Neighbor(v.corner), v.hex.Neighbor(v.corner + 1)}
+}
+
+// Edges returns the three edges that meet at the vertex.
+func (v Vertex) Edges() [3]Edge {
+	return [3]Edge{
+		NewEdge(v.hex, v.corner),
+		NewEdge(v.hex, v.corner+1),
+		NewEdge(v.hex.Neighbor(v.corner), v.corner+2),
+	}
+}
+
+// Neighbors returns the three vertices that are one edge away.
+func (v Vertex) Neighbors() [3]Vertex {
+	var neighbors [3]Vertex
+	for i, e := range v.Edges() {
+		ends := e.Vertices()
+		if ends[0] == v {
+			neighbors[i] = ends[1]
+		} else {
+			neighbors[i] = ends[0]
+		}
+	}
+	return neighbors
+}
+
+// String implements the Stringer interface.
+// It returns the vertex formatted as (q,r,s^corner).
+func (v Vertex) String() string {
+	return fmt.Sprintf("%s^%d", v.hex, v.corner)
+}
+
+// EdgeToPixels returns the screen coordinates of the two ends of the edge.
+func EdgeToPixels(l Layout_i, e Edge) [2]Point {
+	ends := e.Vertices()
+	return [2]Point{VertexToPixel(l, ends[0]), VertexToPixel(l, ends[1])}
+}
+
+// VertexToPixel returns the screen coordinates of the vertex.
+//
+// The vertex is the centroid of the three hexes that share it.
+// That is true for every layout, including layouts with non-uniform sizes.
+func VertexToPixel(l Layout_i, v Vertex) Point {
+	var p Point
+	for _, h := range v.Hexes() {
+		center := l.HexToPixel(h)
+		p.X, p.Y = p.X+center.X, p.Y+center.Y
+	}
+	return Point{X: p.X / 3, Y: p.Y / 3}
+}
+
+// pixelToEdge implements PixelToEdge for any layout.
+func pixelToEdge(l Layout_i, p Point, tolerance float64) (Edge, float64, bool) {
+	h := l.PixelToHexRounded(p)
+	var nearest Edge
+	distance := math.Inf(1)
+	for direction := 0; direction < 6; direction++ {
+		e := NewEdge(h, direction)
+		ends := EdgeToPixels(l, e)
+		if d := distanceToSegment(p, ends[0], ends[1]); d < distance {
+			nearest, distance = e, d
+		}
+	}
+	return nearest, distance, distance <= tolerance
+}
+
+// pixelToVertex implements PixelToVertex for any layout.
+func pixelToVertex(l Layout_i, p Point, tolerance float64) (Vertex, float64, bool) {
+	h := l.PixelToHexRounded(p)
+	var nearest Vertex
+	distance := math.Inf(1)
+	for k := 0; k < 6; k++ {
+		v := NewVertex(h, k)
+		if d := distanceBetween(p, VertexToPixel(l, v)); d < distance {
+			nearest, distance = v, d
+		}
+	}
+	return nearest, distance, distance <= tolerance
+}
+
+// distanceBetween returns the distance between two points.
+func distanceBetween(a, b Point) float64 {
+	return math.Hypot(a.X-b.X, a.Y-b.Y)
+}
+
+// distanceToSegment returns the distance from the point to the line segment from a to b.
+func distanceToSegment(p, a, b Point) float64 {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	lengthSquared := dx*dx + dy*dy
+	if lengthSquared == 0 {
+		return distanceBetween(p, a)
+	}
+	// project p onto the line and clamp the projection to the segment
+	t := ((p.X-a.X)*dx + (p.Y-a.Y)*dy) / lengthSquared
+	t = math.Max(0, math.Min(1, t))
+	return distanceBetween(p, Point{X: a.X + t*dx, Y: a.Y + t*dy})
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestEdge_Canonical(t *testing.T) {
+	h := hexg.NewHex(2, -3, 1)
+	for direction := 0; direction < 6; direction++ {
+		e := hexg.NewEdge(h, direction)
+		if o := hexg.NewEdge(h.Neighbor(direction), direction+3); o != e {
+			t.Errorf("edge: %q/%d: got %v, want %v\n", h.ConciseString(), direction, o, e)
+		}
+		if d := e.Direction(); d < 0 || d > 2 {
+			t.Errorf("edge: %q/%d: direction got %d, want 0..2\n", h.ConciseString(), direction, d)
+		}
+		hexes := e.Hexes()
+		if hexes[0].Distance(hexes[1]) != 1 {
+			t.Errorf("edge: %v: hexes %v are not neighbors\n", e, hexes)
+		}
+	}
+}
+
+func TestVertex_Canonical(t *testing.T) {
+	h := hexg.NewHex(-1, 4, -3)
+	for k := 0; k < 6; k++ {
+		v := hexg.NewVertex(h, k)
+		// the same corner is k+2 of the neighbor in direction k and k+4 of the neighbor in direction k+1
+		if o := hexg.NewVertex(h.Neighbor(k), k+2); o != v {
+			t.Errorf("vertex: %q^%d: k+2: got %v, want %v\n", h.ConciseString(), k, o, v)
+		}
+		if o := hexg.NewVertex(h.Neighbor(k+1), k+4); o != v {
+			t.Errorf("vertex: %q^%d: k+4: got %v, want %v\n", h.ConciseString(), k, o, v)
+		}
+		hexes := v.Hexes()
+		for i := 0; i < 3; i++ {
+			if hexes[i].Distance(hexes[(i+1)%3]) != 1 {
+				t.Errorf("vertex: %v: hexes %v are not neighbors\n", v, hexes)
+			}
+		}
+		for _, e := range v.Edges() {
+			if ends := e.Vertices(); ends[0] != v && ends[1] != v {
+				t.Errorf("vertex: %v: edge %v does not end at vertex\n", v, e)
+			}
+		}
+		for _, n := range v.Neighbors() {
+			if n == v {
+				t.Errorf("vertex: %v: neighbor is itself\n", v)
+			}
+		}
+	}
+}
+
+func TestLayout_PixelToEdge(t *testing.T) {
+	for _, l := range []hexg.Layout_i{
+		hexg.NewVerticalEvenQLayout(hexg.NewPoint(10, 10), hexg.NewPoint(0, 0)),
+		hexg.NewVerticalOddQLayout(hexg.NewPoint(12, 7), hexg.NewPoint(-5, 3)),
+		hexg.NewTribeNetLayout(),
+	} {
+		h := hexg.NewHex(3, -1, -2)
+		center := l.HexToPixel(h)
+		if _, _, ok := l.PixelToEdge(center, 0.1); ok {
+			t.Errorf("%s: edge: center: got ok, want interior\n", l.OffsetType())
+		}
+		if _, _, ok := l.PixelToVertex(center, 0.1); ok {
+			t.Errorf("%s: vertex: center: got ok, want interior\n", l.OffsetType())
+		}
+		for direction := 0; direction < 6; direction++ {
+			want := hexg.NewEdge(h, direction)
+			ends := hexg.EdgeToPixels(l, want)
+			mid := hexg.NewPoint((ends[0].X+ends[1].X)/2, (ends[0].Y+ends[1].Y)/2)
+			// move the click a little towards the center of the hex
+			click := hexg.NewPoint(mid.X+(center.X-mid.X)*0.02, mid.Y+(center.Y-mid.Y)*0.02)
+			got, distance, ok := l.PixelToEdge(click, 0.5)
+			if got != want || !ok {
+				t.Errorf("%s: edge: %d: got %v %v, want %v true\n", l.OffsetType(), direction, got, ok, want)
+			} else if limit := distanceTo(click, mid); !(0 < distance && distance <= limit+1e-9) {
+				t.Errorf("%s: edge: %d: distance got %g, want (0, %g]\n", l.OffsetType(), direction, distance, limit)
+			}
+		}
+		for corner := 0; corner < 6; corner++ {
+			p := l.HexCorner(h, corner)
+			v, distance, ok := l.PixelToVertex(p, 0.5)
+			if !ok || distance > 1e-9 {
+				t.Errorf("%s: vertex: %d: got %v %g, want 0 true\n", l.OffsetType(), corner, ok, distance)
+			}
+			hexes := v.Hexes()
+			if hexes[0] != h && hexes[1] != h && hexes[2] != h {
+				t.Errorf("%s: vertex: %d: %v does not touch %q\n", l.OffsetType(), corner, v, h.ConciseString())
+			}
+		}
+	}
+}
+
+// distanceTo returns the distance between two points.
+func distanceTo(a, b hexg.Point) float64 {
+	return math.Hypot(a.X-b.X, a.Y-b.Y)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+// Error defines a constant error type.
+// Sentinel errors are declared as constants so that callers can't change them.
+type Error string
+
+// Error implements the error interface.
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	// ErrInvalidBearing is returned when a bearing is not valid for the layout.
+	ErrInvalidBearing = Error("invalid bearing")
+
+	// ErrInvalidHex is returned when cube coordinates do not add up to zero.
+	ErrInvalidHex = Error("invalid hex")
+
+	// ErrInvalidLabel is returned when a label can't be formatted or parsed by a label scheme.
+	ErrInvalidLabel = Error("invalid label")
+
+	// ErrInvalidLayoutOffset is returned when the name of a layout offset is not valid.
+	ErrInvalidLayoutOffset = Error("invalid layout offset")
+
+	// ErrInvalidOffsetCoord is returned when offset coordinates can't be parsed.
+	ErrInvalidOffsetCoord = Error("invalid offset coordinates")
+
+	// ErrNoHexes is returned when a function needs at least one hex.
+	ErrNoHexes = Error("no hexes")
+)
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"math"
+)
+
+// Field is a grid of numbers, such as a distance field or an influence map.
+// It has the same layout as Grid[float64]; convert with Grid[float64](f).
+type Field map[uint64]Cell[float64]
+
+// Get returns the value for the hex.
+// Ok is false if the hex is not in the field.
+func (f Field) Get(h Hex) (value float64, ok bool) {
+	return Grid[float64](f).Get(h)
+}
+
+// Set sets the value for the hex, adding the hex if it is not in the field.
+func (f Field) Set(h Hex, value float64) {
+	Grid[float64](f).Set(h, value)
+}
+
+// Hexes returns the hexes in the field sorted by r and then by q.
+func (f Field) Hexes() []Hex {
+	return Grid[float64](f).Hexes()
+}
+
+// Combine returns a field with every hex from both fields.
+// The value of each hex is fn applied to the values from f and g.
+// A hex that is missing from one of the fields has the value 0 in that field.
+func (f Field) Combine(g Field, fn func(a, b float64) float64) Field {
+	result := Field{}
+	for key, cell := range f {
+		other := g[key].Value
+		result[key] = Cell[float64]{Hex: cell.Hex, Value: fn(cell.Value, other)}
+	}
+	for key, cell := range g {
+		if _, ok := f[key]; !ok {
+			result[key] = Cell[float64]{Hex: cell.Hex, Value: fn(0, cell.Value)}
+		}
+	}
+	return result
+}
+
+// Add returns the sum of the fields.
+func (f Field) Add(g Field) Field {
+	return f.Combine(g, func(a, b float64) float64 { return a + b })
+}
+
+// Subtract returns the difference of the fields.
+func (f Field) Subtract(g Field) Field {
+	return f.Combine(g, func(a, b float64) float64 { return a - b })
+}
+
+// Scale returns the field with every value multiplied by k.
+func (f Field) Scale(k float64) Field {
+	result := make(Field, len(f))
+	for key, cell := range f {
+		result[key] = Cell[float64]{Hex: cell.Hex, Value: cell.Value * k}
+	}
+	return result
+}
+
+// DecayFunc converts a distance into an influence.
+type DecayFunc func(distance float64) float64
+
+// LinearDecay returns a decay that falls from 1 at the source to 0 at the radius.
+// If the radius is zero or less, the decay is 1 at the source and 0 everywhere else.
+func LinearDecay(radius float64) DecayFunc {
+	if radius <= 0 {
+		return func(distance float64) float64 {
+			if distance <= 0 {
+				return 1
+			}
+			return 0
+		}
+	}
+	return func(distance float64) float64 {
+		return max(0, 1-distance/radius)
+	}
+}
+
+// ExponentialDecay returns a decay that falls by the factor e^-rate for every
+// unit of distance.
+func ExponentialDecay(rate float64) DecayFunc {
+	return func(distance float64) float64 {
+		return math.Exp(-rate * distance)
+	}
+}
+
+// InverseDecay returns 1 / (1 + distance).
+func InverseDecay(distance float64) float64 {
+	return 1 / (1 + distance)
+}
+
+// FieldOptions are the options for DistanceField and InfluenceMap.
+type FieldOptions struct {
+	// Cost is the cost of moving between neighbors.
+	// If it is nil, every step costs 1 and the search is breadth-first.
+	Cost CostFunc
+
+	// MaxRange is the largest distance from a source that is included.
+	// Zero means there is no limit.
+	MaxRange float64
+
+	// Decay converts distance into influence for InfluenceMap.
+	// If it is nil, InverseDecay is used.
+	Decay DecayFunc
+}
+
+// DistanceField returns the distance from every hex in the store to the
+// nearest source. Distances are measured through the store, so hexes that
+// aren't in the store are obstacles. Hexes that no source can reach within
+// the range are not in the field.
+func DistanceField(gs GridStore, sources []Hex, opts FieldOptions) Field {
+	var indexes []int
+	for n, source := range sources {
+		if gs.Contains(source) {
+			indexes = append(indexes, n)
+		}
+	}
+	field := Field{}
+	for key, cell := range multiSourceSearch(gs, sources, indexes, opts.Cost, opts.MaxRange) {
+		field[key] = Cell[float64]{Hex: cell.Hex, Value: cell.Value.Distance}
+	}
+	return field
+}
+
+// Source is a weighted source of influence.
+type Source struct {
+	Hex Hex
+
+	// Weight scales the influence of the source.
+	// Use a negative weight for threats and a positive one for resources.
+	Weight float64
+}
+
+// InfluenceMap returns the total influence of the sources on every hex in the store.
+// The influence of a source on a hex is its weight times the decay of the
+// distance between them. Hexes that no source reaches are not in the map.
+//
+// Every source adds to every hex it reaches, not just the nearest one, so
+// InfluenceMap runs one search for each hex that has a source. Sources on
+// the same hex share a search. The cost is the number of source hexes times
+// the number of hexes each search reaches; set MaxRange to bound the searches.
+// Use DistanceField for a single search when only the nearest source matters.
+func InfluenceMap(gs GridStore, sources []Source, opts FieldOptions) Field {
+	decay := opts.Decay
+	if decay == nil {
+		decay = InverseDecay
+	}
+	// combine the weights of sources on the same hex, keeping the order of the sources
+	var merged []Source
+	index := map[Hex]int{}
+	for _, source := range sources {
+		if n, ok := index[source.Hex]; ok {
+			merged[n].Weight += source.Weight
+			continue
+		}
+		index[source.Hex] = len(merged)
+		merged = append(merged, source)
+	}
+	influence := Field{}
+	for _, source := range merged {
+		distances := DistanceField(gs, []Hex{source.Hex}, opts)
+		for key, cell := range distances {
+			value := influence[key].Value + source.Weight*decay(cell.Value)
+			influence[key] = Cell[float64]{Hex: cell.Hex, Value: value}
+		}
+	}
+	return influence
+}
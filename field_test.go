@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestDistanceField(t *testing.T) {
+	origin := hexg.NewHex(0, 0, 0)
+	gs := hexg.HexagonalGrid(3)
+
+	field := hexg.DistanceField(gs, []hexg.Hex{origin}, hexg.FieldOptions{})
+	if len(field) != len(gs) {
+		t.Fatalf("bfs: len: got %d, want %d\n", len(field), len(gs))
+	}
+	for _, cell := range field {
+		if cell.Value != float64(cell.Hex.Length()) {
+			t.Errorf("bfs: %v: got %g, want %d\n", cell.Hex, cell.Value, cell.Hex.Length())
+		}
+	}
+
+	// two sources and a range of 1 give two hexagons that share the origin
+	sources := []hexg.Hex{hexg.NewHex(-1, 0, 1), hexg.NewHex(1, 0, -1)}
+	field = hexg.DistanceField(gs, sources, hexg.FieldOptions{MaxRange: 1})
+	if got, want := len(field), 7+7-1; got != want {
+		t.Errorf("range: len: got %d, want %d\n", got, want)
+	}
+	if got, _ := field.Get(origin); got != 1 {
+		t.Errorf("range: origin: got %g, want %g\n", got, 1.0)
+	}
+
+	// distances go around missing hexes
+	row := hexg.Parallelogram(origin, hexg.AxesQR, 0, 4, 0, 1)
+	delete(row, hexg.NewHex(2, 0, -2).Hash())
+	field = hexg.DistanceField(row, []hexg.Hex{origin}, hexg.FieldOptions{})
+	if got, _ := field.Get(hexg.NewHex(3, 0, -3)); got != 4 {
+		t.Errorf("detour: got %g, want %g\n", got, 4.0)
+	}
+
+	// weighted costs use Dijkstra
+	double := func(from, to hexg.Hex) float64 { return 2 }
+	field = hexg.DistanceField(row, []hexg.Hex{origin}, hexg.FieldOptions{Cost: double, MaxRange: 5})
+	if got, ok := field.Get(hexg.NewHex(1, 1, -2)); !ok || got != 4 {
+		t.Errorf("weighted: got %g %v, want %g %v\n", got, ok, 4.0, true)
+	}
+	if _, ok := field.Get(hexg.NewHex(3, 0, -3)); ok {
+		t.Errorf("weighted: out of range hex is in the field\n")
+	}
+}
+
+func TestInfluenceMap(t *testing.T) {
+	gs := hexg.HexagonalGrid(4)
+	resource := hexg.Source{Hex: hexg.NewHex(-2, 0, 2), Weight: 2}
+	threat := hexg.Source{Hex: hexg.NewHex(2, 0, -2), Weight: -1}
+
+	influence := hexg.InfluenceMap(gs, []hexg.Source{resource}, hexg.FieldOptions{Decay: hexg.LinearDecay(2)})
+	for _, tc := range []struct {
+		id     int
+		hex    hexg.Hex
+		expect float64
+	}{
+		{id: 1, hex: hexg.NewHex(-2, 0, 2), expect: 2},
+		{id: 2, hex: hexg.NewHex(-1, 0, 1), expect: 1},
+		{id: 3, hex: hexg.NewHex(0, 0, 0), expect: 0},
+	} {
+		if got, _ := influence.Get(tc.hex); math.Abs(got-tc.expect) > 1e-9 {
+			t.Errorf("%d: %v: got %g, want %g\n", tc.id, tc.hex, got, tc.expect)
+		}
+	}
+
+	// the map of both sources is the sum of the maps of each source
+	opts := hexg.FieldOptions{Decay: hexg.ExponentialDecay(0.5)}
+	both := hexg.InfluenceMap(gs, []hexg.Source{resource, threat}, opts)
+	sum := hexg.InfluenceMap(gs, []hexg.Source{resource}, opts).Add(hexg.InfluenceMap(gs, []hexg.Source{threat}, opts))
+	for _, cell := range both {
+		if got, _ := sum.Get(cell.Hex); math.Abs(got-cell.Value) > 1e-9 {
+			t.Errorf("sum: %v: got %g, want %g\n", cell.Hex, got, cell.Value)
+		}
+	}
+	// by symmetry, the influence at the origin is 2*e^-1 - e^-1
+	if got, want := both[hexg.NewHex(0, 0, 0).Hash()].Value, math.Exp(-1); math.Abs(got-want) > 1e-9 {
+		t.Errorf("origin: got %g, want %g\n", got, want)
+	}
+
+	// sources on the same hex add their weights
+	split := hexg.InfluenceMap(gs, []hexg.Source{{Hex: resource.Hex, Weight: 1.5}, threat, {Hex: resource.Hex, Weight: 0.5}}, opts)
+	for _, cell := range both {
+		if got, _ := split.Get(cell.Hex); math.Abs(got-cell.Value) > 1e-9 {
+			t.Errorf("split: %v: got %g, want %g\n", cell.Hex, got, cell.Value)
+		}
+	}
+}
+
+func TestLinearDecay(t *testing.T) {
+	for _, tc := range []struct {
+		id       int
+		radius   float64
+		distance float64
+		expect   float64
+	}{
+		{id: 1, radius: 2, distance: 0, expect: 1},
+		{id: 2, radius: 2, distance: 1, expect: 0.5},
+		{id: 3, radius: 2, distance: 3, expect: 0},
+		{id: 4, radius: 0, distance: 0, expect: 1},
+		{id: 5, radius: 0, distance: 1, expect: 0},
+		{id: 6, radius: -1, distance: 0, expect: 1},
+		{id: 7, radius: -1, distance: 2, expect: 0},
+	} {
+		if got := hexg.LinearDecay(tc.radius)(tc.distance); got != tc.expect {
+			t.Errorf("%d: radius %g: distance %g: got %g, want %g\n", tc.id, tc.radius, tc.distance, got, tc.expect)
+		}
+	}
+}
+
+func TestField_Combine(t *testing.T) {
+	a, b, c := hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(2, 0, -2)
+	f, g := hexg.Field{}, hexg.Field{}
+	f.Set(a, 1)
+	f.Set(b, 2)
+	g.Set(b, 5)
+	g.Set(c, 7)
+
+	for _, tc := range []struct {
+		id     string
+		field  hexg.Field
+		expect []float64 // values for a, b, c
+	}{
+		{id: "add", field: f.Add(g), expect: []float64{1, 7, 7}},
+		{id: "subtract", field: f.Subtract(g), expect: []float64{1, -3, -7}},
+		{id: "scale", field: g.Scale(-2), expect: []float64{math.NaN(), -10, -14}},
+	} {
+		for n, h := range []hexg.Hex{a, b, c} {
+			got, ok := tc.field.Get(h)
+			if math.IsNaN(tc.expect[n]) {
+				if ok {
+					t.Errorf("%s: %v: got %g, want missing\n", tc.id, h, got)
+				}
+			} else if !ok || got != tc.expect[n] {
+				t.Errorf("%s: %v: got %g %v, want %g\n", tc.id, h, got, ok, tc.expect[n])
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+// FillOptions are the optional rules for FloodFill and ConnectedComponents.
+// The zero value connects every pair of neighbors.
+type FillOptions struct {
+	// Blocked returns true if the edge can't be crossed.
+	// For example, a river can split land into separate components.
+	Blocked func(e Edge) bool
+
+	// Wrap returns the hex on the map for a neighbor that may be off the map.
+	// It is used for maps that wrap around; see WrapColumns.
+	Wrap func(h Hex) Hex
+}
+
+// neighbor returns the neighbor of the hex in the direction, after wrapping.
+// Ok is false if the edge between them is blocked. When the neighbor wraps
+// around, the edge is also checked as it is named from the wrapped side, so
+// an edge on the seam is blocked from both sides.
+func (opts FillOptions) neighbor(h Hex, direction int) (Hex, bool) {
+	if opts.Blocked != nil && opts.Blocked(NewEdge(h, direction)) {
+		return Hex{}, false
+	}
+	n := h.Neighbor(direction)
+	if opts.Wrap != nil {
+		if wrapped := opts.Wrap(n); wrapped != n {
+			if opts.Blocked != nil && opts.Blocked(NewEdge(wrapped, (direction+3)%6)) {
+				return Hex{}, false
+			}
+			n = wrapped
+		}
+	}
+	return n, true
+}
+
+// WrapColumns returns a Wrap function for maps that wrap around from the
+// last column back to the first, like a map of a planet.
+// Columns are the offset columns of the layout, 0 to cols-1.
+// For vertical layouts, cols should be even so that the stagger lines up at the seam.
+// If cols is zero or less, there is nothing to wrap around and the
+// returned function returns every hex unchanged.
+func WrapColumns(l Layout_i, cols int) func(h Hex) Hex {
+	if cols < 1 {
+		return func(h Hex) Hex { return h }
+	}
+	return func(h Hex) Hex {
+		oc := l.HexToOffsetCoord(h)
+		if 0 <= oc.Col && oc.Col < cols {
+			return h
+		}
+		col := ((oc.Col % cols) + cols) % cols
+		return l.OffsetColRowToHex(col, oc.Row)
+	}
+}
+
+// FloodFill returns the hexes in the store that can be reached from the start
+// by moving between neighbors that match the predicate.
+// If match is nil, every hex in the store matches.
+// The result is empty if the start is not in the store or doesn't match.
+func FloodFill(gs GridStore, start Hex, match func(h Hex) bool, opts FillOptions) GridStore {
+	filled := GridStore{}
+	if !gs.Contains(start) || (match != nil && !match(start)) {
+		return filled
+	}
+	filled.Add(start)
+	queue := []Hex{start}
+	for len(queue) > 0 {
+		h := queue[0]
+		queue = queue[1:]
+		for direction := 0; direction < 6; direction++ {
+			n, ok := opts.neighbor(h, direction)
+			if !ok || filled.Contains(n) || !gs.Contains(n) || (match != nil && !match(n)) {
+				continue
+			}
+			filled.Add(n)
+			queue = append(queue, n)
+		}
+	}
+	return filled
+}
+
+// Components is the result of labeling the connected components of a grid.
+type Components struct {
+	// Labels has the component ID for every hex.
+	Labels Grid[int]
+
+	// Sizes is the number of hexes in each component, indexed by ID.
+	Sizes []int
+
+	// Representatives is the first hex, sorted by r and then by q, in each component.
+	// Components are numbered in the order of their representatives.
+	Representatives []Hex
+}
+
+// Component returns the hexes in the component.
+func (c Components) Component(id int) GridStore {
+	gs := GridStore{}
+	for key, cell := range c.Labels {
+		if cell.Value == id {
+			gs[key] = cell.Hex
+		}
+	}
+	return gs
+}
+
+// ConnectedComponents labels the groups of connected hexes in the store.
+func ConnectedComponents(gs GridStore, opts FillOptions) Components {
+	return connectedComponents(gs.Hexes(), gs.Contains, func(a, b Hex) bool { return true }, opts)
+}
+
+// GridComponents labels the groups of connected hexes in the grid.
+// Neighbors are only connected when same returns true for their values,
+// so a terrain grid splits into forests, lakes, and so on.
+func GridComponents[T any](g Grid[T], same func(a, b T) bool, opts FillOptions) Components {
+	return connectedComponents(g.Hexes(), g.Contains, func(a, b Hex) bool {
+		va, _ := g.Get(a)
+		vb, _ := g.Get(b)
+		return same(va, vb)
+	}, opts)
+}
+
+// connectedComponents labels the hexes with a breadth-first search from each
+// unlabeled hex, in order, so the labels don't depend on map iteration order.
+func connectedComponents(hexes []Hex, contains func(h Hex) bool, joined func(a, b Hex) bool, opts FillOptions) Components {
+	c := Components{Labels: Grid[int]{}}
+	for _, start := range hexes {
+		if c.Labels.Contains(start) {
+			continue
+		}
+		id := len(c.Sizes)
+		c.Labels.Set(start, id)
+		c.Sizes = append(c.Sizes, 1)
+		c.Representatives = append(c.Representatives, start)
+		queue := []Hex{start}
+		for len(queue) > 0 {
+			h := queue[0]
+			queue = queue[1:]
+			for direction := 0; direction < 6; direction++ {
+				n, ok := opts.neighbor(h, direction)
+				if !ok || c.Labels.Contains(n) || !contains(n) || !joined(h, n) {
+					continue
+				}
+				c.Labels.Set(n, id)
+				c.Sizes[id]++
+				queue = append(queue, n)
+			}
+		}
+	}
+	return c
+}
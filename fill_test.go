@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestFloodFill(t *testing.T) {
+	origin := hexg.NewHex(0, 0, 0)
+	gs := hexg.HexagonalGrid(3)
+
+	// a ring of non-matching hexes stops the fill
+	notRingTwo := func(h hexg.Hex) bool { return h.Length() != 2 }
+	if got := len(hexg.FloodFill(gs, origin, notRingTwo, hexg.FillOptions{})); got != 7 {
+		t.Errorf("ring: got %d, want %d\n", got, 7)
+	}
+	if got := len(hexg.FloodFill(gs, origin, nil, hexg.FillOptions{})); got != len(gs) {
+		t.Errorf("nil: got %d, want %d\n", got, len(gs))
+	}
+	if got := len(hexg.FloodFill(gs, hexg.NewHex(0, -2, 2), notRingTwo, hexg.FillOptions{})); got != 0 {
+		t.Errorf("start: got %d, want %d\n", got, 0)
+	}
+	if got := len(hexg.FloodFill(gs, hexg.NewHex(9, -9, 0), nil, hexg.FillOptions{})); got != 0 {
+		t.Errorf("outside: got %d, want %d\n", got, 0)
+	}
+
+	// a blocked edge splits a row of hexes
+	row := hexg.Parallelogram(origin, hexg.AxesQR, 0, 4, 0, 0)
+	river := hexg.NewEdge(hexg.NewHex(1, 0, -1), hexg.ESE)
+	blocked := hexg.FillOptions{Blocked: func(e hexg.Edge) bool { return e == river }}
+	if got := len(hexg.FloodFill(row, origin, nil, blocked)); got != 2 {
+		t.Errorf("blocked: got %d, want %d\n", got, 2)
+	}
+
+	// wrapping joins the first and last columns
+	l := hexg.NewTribeNetLayout()
+	first, last := l.OffsetColRowToHex(0, 0), l.OffsetColRowToHex(5, 0)
+	ends := func(h hexg.Hex) bool { return h == first || h == last }
+	sheet := l.OffsetRect(0, 5, 0, 0)
+	if got := len(hexg.FloodFill(sheet, first, ends, hexg.FillOptions{})); got != 1 {
+		t.Errorf("no wrap: got %d, want %d\n", got, 1)
+	}
+	wrapped := hexg.FillOptions{Wrap: hexg.WrapColumns(l, 6)}
+	if got := hexg.FloodFill(sheet, first, ends, wrapped); len(got) != 2 || !got.Contains(last) {
+		t.Errorf("wrap: got %d, want %d\n", len(got), 2)
+	}
+
+	// a river on the seam is blocked from both sides
+	eq := hexg.NewVerticalEvenQLayout(hexg.NewPoint(10, 10), hexg.NewPoint(0, 0))
+	sheet = eq.OffsetRect(0, 3, 0, 0)
+	rivers := map[hexg.Edge]bool{}
+	for direction := 0; direction < 6; direction++ {
+		h := eq.OffsetColRowToHex(3, 0)
+		if eq.HexToOffsetCoord(h.Neighbor(direction)).Col == 4 {
+			rivers[hexg.NewEdge(h, direction)] = true
+		}
+		h = eq.OffsetColRowToHex(1, 0)
+		if h.Neighbor(direction) == eq.OffsetColRowToHex(2, 0) {
+			rivers[hexg.NewEdge(h, direction)] = true
+		}
+	}
+	seam := hexg.FillOptions{Blocked: func(e hexg.Edge) bool { return rivers[e] }, Wrap: hexg.WrapColumns(eq, 4)}
+	for col := 0; col < 4; col++ {
+		if got := len(hexg.FloodFill(sheet, eq.OffsetColRowToHex(col, 0), nil, seam)); got != 2 {
+			t.Errorf("seam: col %d: got %d, want %d\n", col, got, 2)
+		}
+	}
+	if c := hexg.ConnectedComponents(sheet, seam); !reflect.DeepEqual(c.Sizes, []int{2, 2}) {
+		t.Errorf("seam: sizes: got %v, want %v\n", c.Sizes, []int{2, 2})
+	}
+
+	// a map with no columns doesn't wrap
+	for _, cols := range []int{0, -6} {
+		if got := hexg.WrapColumns(l, cols)(l.OffsetColRowToHex(-1, 0)); got != l.OffsetColRowToHex(-1, 0) {
+			t.Errorf("wrap %d: got %v, want %v\n", cols, got, l.OffsetColRowToHex(-1, 0))
+		}
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	gs := hexg.HexagonalGrid(1)
+	gs.Add(hexg.NewHex(5, -5, 0))
+	gs.Add(hexg.NewHex(0, 5, -5))
+	gs.Add(hexg.NewHex(1, 5, -6))
+
+	c := hexg.ConnectedComponents(gs, hexg.FillOptions{})
+	if want := []int{1, 7, 2}; !reflect.DeepEqual(c.Sizes, want) {
+		t.Errorf("sizes: got %v, want %v\n", c.Sizes, want)
+	}
+	if want := []hexg.Hex{hexg.NewHex(5, -5, 0), hexg.NewHex(0, -1, 1), hexg.NewHex(0, 5, -5)}; !reflect.DeepEqual(c.Representatives, want) {
+		t.Errorf("representatives: got %v, want %v\n", c.Representatives, want)
+	}
+	if id, ok := c.Labels.Get(hexg.NewHex(0, 0, 0)); !ok || id != 1 {
+		t.Errorf("labels: origin: got %d %v, want %d %v\n", id, ok, 1, true)
+	}
+	if got := len(c.Component(2)); got != 2 {
+		t.Errorf("component: got %d, want %d\n", got, 2)
+	}
+
+	// a river between q=1 and q=2 splits the row
+	row := hexg.Parallelogram(hexg.NewHex(0, 0, 0), hexg.AxesQR, 0, 3, 0, 0)
+	river := hexg.NewEdge(hexg.NewHex(2, 0, -2), hexg.WNW)
+	c = hexg.ConnectedComponents(row, hexg.FillOptions{Blocked: func(e hexg.Edge) bool { return e == river }})
+	if want := []int{2, 2}; !reflect.DeepEqual(c.Sizes, want) {
+		t.Errorf("river: sizes: got %v, want %v\n", c.Sizes, want)
+	}
+}
+
+func TestGridComponents(t *testing.T) {
+	terrain := []string{"forest", "forest", "water", "forest", "forest", "forest"}
+	g := hexg.NewGrid(hexg.Parallelogram(hexg.NewHex(0, 0, 0), hexg.AxesQR, 0, 5, 0, 0), func(h hexg.Hex) string {
+		return terrain[h.Distance(hexg.NewHex(0, 0, 0))]
+	})
+
+	c := hexg.GridComponents(g, func(a, b string) bool { return a == b }, hexg.FillOptions{})
+	if want := []int{2, 1, 3}; !reflect.DeepEqual(c.Sizes, want) {
+		t.Errorf("sizes: got %v, want %v\n", c.Sizes, want)
+	}
+	if want := []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(2, 0, -2), hexg.NewHex(3, 0, -3)}; !reflect.DeepEqual(c.Representatives, want) {
+		t.Errorf("representatives: got %v, want %v\n", c.Representatives, want)
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseHex parses the printed forms of a Hex:
+//   - cube coordinates from String, "q,r,s" (for example "1,-3,2")
+//   - cube coordinates from ConciseString, "+q+r+s" (for example "+1-3+2")
+//   - axial coordinates, "q,r" or "+q+r" (for example "1,-3" or "+1-3")
+//
+// Spaces around the numbers are ignored.
+// Returns ErrInvalidHex if the input can't be parsed or if q + r + s is not zero.
+func ParseHex(input string) (Hex, error) {
+	values, ok := parseCoordinates(input)
+	switch {
+	case ok && len(values) == 2:
+		return NewHexFromAxialCoords(values[0], values[1]), nil
+	case ok && len(values) == 3:
+		return TryNewHex(values[0], values[1], values[2])
+	}
+	return Hex{}, fmt.Errorf("%q: %w", input, ErrInvalidHex)
+}
+
+// ParseOffsetCoord parses the printed forms of an OffsetCoord:
+//   - "col,row" from String (for example "3,-2")
+//   - "+col+row" from ConciseString (for example "+3-2")
+//
+// Spaces around the numbers are ignored.
+// Returns ErrInvalidOffsetCoord if the input can't be parsed.
+func ParseOffsetCoord(input string) (OffsetCoord, error) {
+	values, ok := parseCoordinates(input)
+	if !ok || len(values) != 2 {
+		return OffsetCoord{}, fmt.Errorf("%q: %w", input, ErrInvalidOffsetCoord)
+	}
+	return OffsetCoord{Col: values[0], Row: values[1]}, nil
+}
+
+// parseCoordinates splits the input into integers.
+// The input is either separated by commas ("1,-3,2") or every value has a sign ("+1-3+2").
+func parseCoordinates(input string) ([]int, bool) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, false
+	}
+
+	var fields []string
+	if strings.Contains(input, ",") {
+		fields = strings.Split(input, ",")
+	} else {
+		// concise form: every value starts with a sign
+		if input[0] != '+' && input[0] != '-' {
+			return nil, false
+		}
+		start := 0
+		for i := 1; i < len(input); i++ {
+			if input[i] == '+' || input[i] == '-' {
+				fields, start = append(fields, input[start:i]), i
+			}
+		}
+		fields = append(fields, input[start:])
+	}
+
+	values := make([]int, 0, len(fields))
+	for _, field := range fields {
+		value, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, value)
+	}
+	return values, true
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The verbs are:
+//   - %v and %s print cube coordinates, "q,r,s"
+//   - %+v and %+s print cube coordinates with signs, "+q+r+s"
+//   - %a prints axial coordinates, "q,r"; %+a prints "+q+r"
+//   - %q prints the %s or %+s form in double quotes
+//   - %#v prints the Go syntax, "hexg.NewHex(q, r, s)"
+//
+// Width and the '-' flag pad the result, so %-12v works in tables.
+// Use In to print offset coordinates or map labels.
+//
+// Other verbs print the same thing they did before Hex implemented
+// fmt.Formatter: %x and %X format the String form, and the rest format the
+// fields, so %d prints "{q r s}".
+func (h Hex) Format(f fmt.State, verb rune) {
+	var text string
+	switch verb {
+	case 'v', 's', 'q':
+		if verb == 'v' && f.Flag('#') {
+			text = fmt.Sprintf("hexg.NewHex(%d, %d, %d)", h.q, h.r, h.s)
+		} else if f.Flag('+') {
+			text = h.ConciseString()
+		} else {
+			text = h.String()
+		}
+	case 'a':
+		if f.Flag('+') {
+			text = fmt.Sprintf("%+d%+d", h.q, h.r)
+		} else {
+			text = fmt.Sprintf("%d,%d", h.q, h.r)
+		}
+	case 'x', 'X':
+		// fmt formats a Stringer with these verbs by formatting its string
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), h.String())
+		return
+	default:
+		// plainHex has the fields of Hex but none of its methods, so fmt
+		// falls back to its default formatting for a struct
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), plainHex(h))
+		return
+	}
+	if verb == 'q' {
+		text = strconv.Quote(text)
+	}
+	writePadded(f, text)
+}
+
+// plainHex is a Hex without the Formatter and Stringer methods.
+type plainHex Hex
+
+// LayoutHex is a Hex paired with a layout so that it can be printed in
+// offset coordinates or as a map label.
+type LayoutHex struct {
+	Layout Layout_i
+	Hex    Hex
+
+	// Labels is the label scheme for %L.
+	// If it is nil, the layout's scheme is used (see LabeledLayout).
+	Labels LabelScheme
+}
+
+// LabeledLayout is implemented by layouts that have a default label scheme,
+// like TribeNetLayout.
+type LabeledLayout interface {
+	LabelScheme() LabelScheme
+}
+
+// In returns the hex paired with the layout for printing.
+//
+//	fmt.Printf("%o\n", h.In(l)) // prints the offset coordinates
+func (h Hex) In(l Layout_i) LayoutHex {
+	return LayoutHex{Layout: l, Hex: h}
+}
+
+// WithLabels returns a copy that prints %L with the label scheme.
+//
+//	fmt.Printf("%L\n", h.In(l).WithLabels(hexg.NumberScheme{Layout: l, Digits: 2, First: 1}))
+func (lh LayoutHex) WithLabels(scheme LabelScheme) LayoutHex {
+	lh.Labels = scheme
+	return lh
+}
+
+// labelScheme returns the label scheme for %L, or nil if there isn't one.
+func (lh LayoutHex) labelScheme() LabelScheme {
+	if lh.Labels != nil {
+		return lh.Labels
+	} else if ll, ok := lh.Layout.(LabeledLayout); ok {
+		return ll.LabelScheme()
+	}
+	return nil
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// It accepts all the verbs that Hex accepts, and adds:
+//   - %o prints offset coordinates, "col,row"; %+o prints "+col+row"
+//   - %L prints the map label from the label scheme (for example, "AB 0102"
+//     for TribeNet layouts). Without a scheme, it prints offset coordinates.
+func (lh LayoutHex) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'o':
+		oc := lh.Layout.HexToOffsetCoord(lh.Hex)
+		if f.Flag('+') {
+			writePadded(f, oc.ConciseString())
+		} else {
+			writePadded(f, oc.String())
+		}
+	case 'L':
+		if scheme := lh.labelScheme(); scheme != nil {
+			label, err := scheme.Format(lh.Hex)
+			if err != nil {
+				writePadded(f, fmt.Sprintf("%%!L(%v)", err))
+				return
+			}
+			writePadded(f, label)
+			return
+		}
+		writePadded(f, lh.Layout.HexToOffsetCoord(lh.Hex).String())
+	default:
+		lh.Hex.Format(f, verb)
+	}
+}
+
+// writePadded writes the text, padded to the width from the state.
+func writePadded(f fmt.State, text string) {
+	width, ok := f.Width()
+	if !ok || len(text) >= width {
+		_, _ = f.Write([]byte(text))
+		return
+	}
+	padding := strings.Repeat(" ", width-len(text))
+	if f.Flag('-') {
+		_, _ = f.Write([]byte(text + padding))
+	} else {
+		_, _ = f.Write([]byte(padding + text))
+	}
+}
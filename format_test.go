@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestParseHex(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect string
+		err    error
+	}{
+		{id: 1, input: "1,-3,2", expect: "+1-3+2"},
+		{id: 2, input: "+1-3+2", expect: "+1-3+2"},
+		{id: 3, input: "-4+0+4", expect: "-4+0+4"},
+		{id: 4, input: " 1 , -3 , 2 ", expect: "+1-3+2"},
+		{id: 5, input: "1,-3", expect: "+1-3+2"},
+		{id: 6, input: "+1-3", expect: "+1-3+2"},
+		{id: 7, input: "0,0,0", expect: "+0+0+0"},
+		{id: 8, input: "1,1,1", err: hexg.ErrInvalidHex},
+		{id: 9, input: "1-3+2", err: hexg.ErrInvalidHex},
+		{id: 10, input: "1,-3,2,0", err: hexg.ErrInvalidHex},
+		{id: 11, input: "a,b", err: hexg.ErrInvalidHex},
+		{id: 12, input: "", err: hexg.ErrInvalidHex},
+		{id: 13, input: "+1", err: hexg.ErrInvalidHex},
+	} {
+		h, err := hexg.ParseHex(tc.input)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%d: %q: error: got %v, want %v\n", tc.id, tc.input, err, tc.err)
+		} else if err == nil && h.ConciseString() != tc.expect {
+			t.Errorf("%d: %q: got %q, want %q\n", tc.id, tc.input, h.ConciseString(), tc.expect)
+		}
+	}
+
+	// every printed form round-trips
+	for _, h := range hexg.HexagonalGrid(3) {
+		for _, form := range []string{h.String(), h.ConciseString(), fmt.Sprintf("%a", h), fmt.Sprintf("%+a", h)} {
+			if got, err := hexg.ParseHex(form); err != nil || got != h {
+				t.Errorf("round-trip: %q: got %q %v, want %q\n", form, got.ConciseString(), err, h.ConciseString())
+			}
+		}
+	}
+}
+
+func TestParseOffsetCoord(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect hexg.OffsetCoord
+		err    error
+	}{
+		{id: 1, input: "3,-2", expect: hexg.OffsetCoord{Col: 3, Row: -2}},
+		{id: 2, input: "+3-2", expect: hexg.OffsetCoord{Col: 3, Row: -2}},
+		{id: 3, input: "-0+15", expect: hexg.OffsetCoord{Col: 0, Row: 15}},
+		{id: 4, input: "3,-2,1", err: hexg.ErrInvalidOffsetCoord},
+		{id: 5, input: "3", err: hexg.ErrInvalidOffsetCoord},
+		{id: 6, input: "AA 0101", err: hexg.ErrInvalidOffsetCoord},
+	} {
+		oc, err := hexg.ParseOffsetCoord(tc.input)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%d: %q: error: got %v, want %v\n", tc.id, tc.input, err, tc.err)
+		} else if err == nil && oc != tc.expect {
+			t.Errorf("%d: %q: got %v, want %v\n", tc.id, tc.input, oc, tc.expect)
+		}
+		if err == nil {
+			if again, err := hexg.ParseOffsetCoord(oc.String()); err != nil || again != oc {
+				t.Errorf("%d: %q: round-trip: got %v %v, want %v\n", tc.id, tc.input, again, err, oc)
+			}
+		}
+	}
+}
+
+func TestHex_Format(t *testing.T) {
+	h := hexg.NewHex(1, -3, 2)
+	tn := hexg.NewTribeNetLayout()
+	for _, tc := range []struct {
+		id     int
+		format string
+		arg    any
+		expect string
+	}{
+		{id: 1, format: "%v", arg: h, expect: "1,-3,2"},
+		{id: 2, format: "%s", arg: h, expect: "1,-3,2"},
+		{id: 3, format: "%+v", arg: h, expect: "+1-3+2"},
+		{id: 4, format: "%q", arg: h, expect: `"1,-3,2"`},
+		{id: 5, format: "%+q", arg: h, expect: `"+1-3+2"`},
+		{id: 6, format: "%a", arg: h, expect: "1,-3"},
+		{id: 7, format: "%+a", arg: h, expect: "+1-3"},
+		{id: 8, format: "%#v", arg: h, expect: "hexg.NewHex(1, -3, 2)"},
+		{id: 9, format: "[%8v]", arg: h, expect: "[  1,-3,2]"},
+		{id: 10, format: "[%-8v]", arg: h, expect: "[1,-3,2  ]"},
+		{id: 11, format: "%d", arg: h, expect: "{1 -3 2}"},
+		{id: 12, format: "%v", arg: h.In(tn), expect: "1,-3,2"},
+		{id: 13, format: "%o", arg: h.In(tn), expect: "1,-3"},
+		{id: 14, format: "%+o", arg: h.In(tn), expect: "+1-3"},
+		{id: 15, format: "%L", arg: hexg.NewHex(1, 0, -1).In(tn), expect: "AA 0201"},
+		{id: 16, format: "%L", arg: h.In(hexg.NewVerticalEvenQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))), expect: "1,-2"},
+		{id: 17, format: "%L", arg: h.In(tn), expect: "%!L(invalid col, row: 1, -3: invalid label)"},
+		// verbs that Format doesn't define print what they did before Hex was a Formatter
+		{id: 18, format: "%3d", arg: h, expect: "{  1  -3   2}"},
+		{id: 19, format: "%x", arg: h, expect: "312c2d332c32"},
+		{id: 20, format: "% X", arg: h, expect: "31 2C 2D 33 2C 32"},
+		{id: 21, format: "%t", arg: h, expect: "{%!t(int=1) %!t(int=-3) %!t(int=2)}"},
+		{id: 22, format: "%d", arg: h.In(tn), expect: "{1 -3 2}"},
+	} {
+		if got := fmt.Sprintf(tc.format, tc.arg); got != tc.expect {
+			t.Errorf("%d: %s: got %q, want %q\n", tc.id, tc.format, got, tc.expect)
+		}
+	}
+}
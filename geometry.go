@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import "math"
+
+// Geometry helpers for sizing canvases and print sheets.
+// See https://www.redblobgames.com/grids/hexagons/#basics,
+// https://www.redblobgames.com/grids/hexagons/#spacing.
+//
+// From the source:
+// The size is the distance from the center to a corner. For pointy-top hexes,
+// the width is sqrt(3) * size and the height is 2 * size. For flat-top hexes,
+// the width is 2 * size and the height is sqrt(3) * size.
+//
+// The layout's size is a Point, so the helpers work with non-uniform scaling
+// by measuring the layout instead of assuming that size.X equals size.Y.
+
+// HexWidth returns the width of a hex in pixels.
+func HexWidth(l Layout_i) float64 {
+	return 2 * hexExtent(l).X
+}
+
+// HexHeight returns the height of a hex in pixels.
+func HexHeight(l Layout_i) float64 {
+	return 2 * hexExtent(l).Y
+}
+
+// HorizontalSpacing returns the horizontal distance in pixels between the
+// centers of hexes in adjacent columns.
+// That is 3/4 of the width for flat-top hexes and the full width for pointy-top hexes.
+func HorizontalSpacing(l Layout_i) float64 {
+	return math.Abs(l.HexToPixel(Hex{q: 1, r: 0, s: -1}).X - l.HexToPixel(Hex{}).X)
+}
+
+// VerticalSpacing returns the vertical distance in pixels between the
+// centers of hexes in adjacent rows.
+// That is the full height for flat-top hexes and 3/4 of the height for pointy-top hexes.
+func VerticalSpacing(l Layout_i) float64 {
+	return math.Abs(l.HexToPixel(Hex{q: 0, r: 1, s: -1}).Y - l.HexToPixel(Hex{}).Y)
+}
+
+// OuterRadius returns the distance from the center of a hex to a corner.
+// X and Y are the horizontal and vertical radii; they are equal for uniform scaling.
+func OuterRadius(l Layout_i) Point {
+	extent := hexExtent(l)
+	if l.IsVertical() {
+		// flat-top hexes have a corner on the x-axis
+		return Point{X: extent.X, Y: extent.Y * 2 / math.Sqrt(3)}
+	}
+	// pointy-top hexes have a corner on the y-axis
+	return Point{X: extent.X * 2 / math.Sqrt(3), Y: extent.Y}
+}
+
+// InnerRadius returns the distance from the center of a hex to the middle of a side.
+// X and Y are the horizontal and vertical radii; they are equal for uniform scaling.
+func InnerRadius(l Layout_i) Point {
+	outer := OuterRadius(l)
+	return Point{X: outer.X * math.Sqrt(3) / 2, Y: outer.Y * math.Sqrt(3) / 2}
+}
+
+// HexArea returns the area of a hex in square pixels.
+func HexArea(l Layout_i) float64 {
+	// shoelace formula over the corners
+	corners := l.PolygonCornerOffsets()
+	var area float64
+	for i := 0; i < 6; i++ {
+		a, b := corners[i], corners[(i+1)%6]
+		area += a.X*b.Y - b.X*a.Y
+	}
+	return math.Abs(area) / 2
+}
+
+// MapPixelSize returns the width and height in pixels of a map that is
+// cols offset columns wide and rows offset rows tall.
+// The size includes the corners of the hexes on the edges of the map,
+// so it is the smallest canvas that will hold the whole map.
+func MapPixelSize(l Layout_i, cols, rows int) (width, height float64) {
+	if cols <= 0 || rows <= 0 {
+		return 0, 0
+	}
+	hs, vs := HorizontalSpacing(l), VerticalSpacing(l)
+	if l.IsVertical() {
+		// columns are staggered by half a hex vertically
+		width, height = HexWidth(l)+float64(cols-1)*hs, float64(rows)*vs
+		if cols > 1 {
+			height += vs / 2
+		}
+		return width, height
+	}
+	// rows are staggered by half a hex horizontally
+	width, height = float64(cols)*hs, HexHeight(l)+float64(rows-1)*vs
+	if rows > 1 {
+		width += hs / 2
+	}
+	return width, height
+}
+
+// hexExtent returns the half-width and half-height of a hex in the layout.
+func hexExtent(l Layout_i) Point {
+	var extent Point
+	for i := 0; i < 6; i++ {
+		offset := l.PolygonCornerOffset(i)
+		extent.X, extent.Y = math.Max(extent.X, math.Abs(offset.X)), math.Max(extent.Y, math.Abs(offset.Y))
+	}
+	return extent
+}
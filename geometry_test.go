@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestGeometry_Metrics(t *testing.T) {
+	sqrt3 := math.Sqrt(3)
+	for _, tc := range []struct {
+		id                                int
+		l                                 hexg.Layout_i
+		width, height, hSpacing, vSpacing float64
+		outer, inner                      hexg.Point
+		area                              float64
+	}{
+		{
+			id: 1, l: hexg.NewVerticalOddQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0)),
+			width: 2, height: sqrt3, hSpacing: 1.5, vSpacing: sqrt3,
+			outer: hexg.NewPoint(1, 1), inner: hexg.NewPoint(sqrt3/2, sqrt3/2),
+			area: 3 * sqrt3 / 2,
+		},
+		{
+			id: 2, l: hexg.NewVerticalEvenQLayout(hexg.NewPoint(10, 10), hexg.NewPoint(7, -3)),
+			width: 20, height: 10 * sqrt3, hSpacing: 15, vSpacing: 10 * sqrt3,
+			outer: hexg.NewPoint(10, 10), inner: hexg.NewPoint(5*sqrt3, 5*sqrt3),
+			area: 150 * sqrt3,
+		},
+		{
+			// non-uniform scaling
+			id: 3, l: hexg.NewVerticalOddQLayout(hexg.NewPoint(4, 2), hexg.NewPoint(0, 0)),
+			width: 8, height: 2 * sqrt3, hSpacing: 6, vSpacing: 2 * sqrt3,
+			outer: hexg.NewPoint(4, 2), inner: hexg.NewPoint(2*sqrt3, sqrt3),
+			area: 3 * sqrt3 / 2 * 8,
+		},
+	} {
+		for _, got := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"width", hexg.HexWidth(tc.l), tc.width},
+			{"height", hexg.HexHeight(tc.l), tc.height},
+			{"horizontal spacing", hexg.HorizontalSpacing(tc.l), tc.hSpacing},
+			{"vertical spacing", hexg.VerticalSpacing(tc.l), tc.vSpacing},
+			{"area", hexg.HexArea(tc.l), tc.area},
+		} {
+			if math.Abs(got.got-got.want) > 1e-9 {
+				t.Errorf("%d: %s: got %g, want %g\n", tc.id, got.name, got.got, got.want)
+			}
+		}
+		if got := hexg.OuterRadius(tc.l); !closeTo(got, tc.outer) {
+			t.Errorf("%d: outer radius: got %v, want %v\n", tc.id, got, tc.outer)
+		}
+		if got := hexg.InnerRadius(tc.l); !closeTo(got, tc.inner) {
+			t.Errorf("%d: inner radius: got %v, want %v\n", tc.id, got, tc.inner)
+		}
+	}
+}
+
+func TestGeometry_MapPixelSize(t *testing.T) {
+	for _, l := range []hexg.Layout_i{
+		hexg.NewVerticalOddQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0)),
+		hexg.NewVerticalEvenQLayout(hexg.NewPoint(5, 3), hexg.NewPoint(0, 0)),
+		hexg.NewTribeNetLayout(),
+	} {
+		if w, h := hexg.MapPixelSize(l, 0, 5); w != 0 || h != 0 {
+			t.Errorf("%s: 0x5: got %gx%g, want 0x0\n", l.OffsetType(), w, h)
+		}
+		for _, size := range []struct{ cols, rows int }{{1, 1}, {2, 1}, {1, 3}, {5, 4}, {30, 21}} {
+			var hexes []hexg.Hex
+			for col := 0; col < size.cols; col++ {
+				for row := 0; row < size.rows; row++ {
+					hexes = append(hexes, l.OffsetColRowToHex(col, row))
+				}
+			}
+			b, err := hexg.Bounds(l, hexes...)
+			if err != nil {
+				t.Fatalf("%s: %dx%d: bounds: %v\n", l.OffsetType(), size.cols, size.rows, err)
+			}
+			w, h := hexg.MapPixelSize(l, size.cols, size.rows)
+			if math.Abs(w-b.Width()) > 1e-9 || math.Abs(h-b.Height()) > 1e-9 {
+				t.Errorf("%s: %dx%d: got %gx%g, want %gx%g\n", l.OffsetType(), size.cols, size.rows, w, h, b.Width(), b.Height())
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"slices"
+)
+
+// Cell is a hex with a payload.
+type Cell[T any] struct {
+	Hex   Hex
+	Value T
+}
+
+// Grid is a map of hexes with payloads, indexed by the hash of the Hex.
+// It is the payload-carrying version of GridStore.
+type Grid[T any] map[uint64]Cell[T]
+
+// NewGrid returns a grid with a cell for every hex in the store.
+// The value of each cell is the result of calling value with the hex.
+// If value is nil, every cell has the zero value.
+func NewGrid[T any](gs GridStore, value func(h Hex) T) Grid[T] {
+	g := make(Grid[T], len(gs))
+	for key, h := range gs {
+		var v T
+		if value != nil {
+			v = value(h)
+		}
+		g[key] = Cell[T]{Hex: h, Value: v}
+	}
+	return g
+}
+
+// Contains returns true if the hex is in the grid.
+func (g Grid[T]) Contains(h Hex) bool {
+	_, ok := g[h.Hash()]
+	return ok
+}
+
+// Get returns the value for the hex.
+// Ok is false if the hex is not in the grid.
+func (g Grid[T]) Get(h Hex) (value T, ok bool) {
+	cell, ok := g[h.Hash()]
+	return cell.Value, ok
+}
+
+// Set sets the value for the hex, adding the hex if it is not in the grid.
+func (g Grid[T]) Set(h Hex, value T) {
+	g[h.Hash()] = Cell[T]{Hex: h, Value: value}
+}
+
+// Cells returns the cells in the grid sorted by r and then by q.
+func (g Grid[T]) Cells() []Cell[T] {
+	cells := make([]Cell[T], 0, len(g))
+	for _, cell := range g {
+		cells = append(cells, cell)
+	}
+	slices.SortFunc(cells, func(a, b Cell[T]) int {
+		return compareHexes(a.Hex, b.Hex)
+	})
+	return cells
+}
+
+// Hexes returns the hexes in the grid sorted by r and then by q.
+func (g Grid[T]) Hexes() []Hex {
+	hexes := make([]Hex, 0, len(g))
+	for _, cell := range g {
+		hexes = append(hexes, cell.Hex)
+	}
+	slices.SortFunc(hexes, compareHexes)
+	return hexes
+}
+
+// GridStore returns the hexes in the grid without the payloads.
+func (g Grid[T]) GridStore() GridStore {
+	gs := make(GridStore, len(g))
+	for key, cell := range g {
+		gs[key] = cell.Hex
+	}
+	return gs
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestGrid(t *testing.T) {
+	g := hexg.NewGrid(hexg.HexagonalGrid(1), func(h hexg.Hex) int {
+		return h.Length()
+	})
+	if len(g) != 7 {
+		t.Fatalf("grid: len: got %d, want %d\n", len(g), 7)
+	}
+
+	origin, far := hexg.NewHex(0, 0, 0), hexg.NewHex(3, -3, 0)
+	if v, ok := g.Get(origin); !ok || v != 0 {
+		t.Errorf("get: origin: got %d %v, want %d %v\n", v, ok, 0, true)
+	}
+	if v, ok := g.Get(origin.Neighbor(hexg.N)); !ok || v != 1 {
+		t.Errorf("get: neighbor: got %d %v, want %d %v\n", v, ok, 1, true)
+	}
+	if _, ok := g.Get(far); ok || g.Contains(far) {
+		t.Errorf("get: far: got %v, want %v\n", ok, false)
+	}
+
+	g.Set(far, 42)
+	if v, ok := g.Get(far); !ok || v != 42 {
+		t.Errorf("set: far: got %d %v, want %d %v\n", v, ok, 42, true)
+	}
+
+	// cells and hexes are in the same order as GridStore.Hexes
+	hexes, cells := g.GridStore().Hexes(), g.Cells()
+	if len(hexes) != 8 || len(cells) != 8 || len(g.Hexes()) != 8 {
+		t.Fatalf("order: len: got %d %d %d, want %d\n", len(hexes), len(cells), len(g.Hexes()), 8)
+	}
+	for n, h := range g.Hexes() {
+		if h != hexes[n] || cells[n].Hex != hexes[n] {
+			t.Errorf("order: %d: got %v %v, want %v\n", n, h, cells[n].Hex, hexes[n])
+		}
+	}
+
+	// a nil value function gives zero values
+	for _, cell := range hexg.NewGrid[string](hexg.HexagonalGrid(1), nil) {
+		if cell.Value != "" {
+			t.Errorf("nil: %v: got %q, want %q\n", cell.Hex, cell.Value, "")
+		}
+	}
+}
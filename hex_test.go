@@ -3,6 +3,8 @@
 package hexg_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/maloquacious/hexg"
@@ -71,3 +73,92 @@ func TestHex_Neighbor(t *testing.T) {
 		from = to
 	}
 }
+
+func TestTryNewHex(t *testing.T) {
+	for _, tc := range []struct {
+		id      int
+		q, r, s int
+		expect  string
+		err     error
+	}{
+		{id: 1, q: 0, r: 0, s: 0, expect: "+0+0+0"},
+		{id: 2, q: 1, r: -3, s: 2, expect: "+1-3+2"},
+		{id: 3, q: 1, r: 1, s: 1, err: hexg.ErrInvalidHex},
+		{id: 4, q: -1, r: 0, s: 0, err: hexg.ErrInvalidHex},
+	} {
+		h, err := hexg.TryNewHex(tc.q, tc.r, tc.s)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%d: error: got %v, want %v\n", tc.id, err, tc.err)
+		} else if err == nil && h.ConciseString() != tc.expect {
+			t.Errorf("%d: hex: got %q, want %q\n", tc.id, h.ConciseString(), tc.expect)
+		}
+	}
+}
+
+func TestParseLayoutOffset(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect hexg.LayoutOffset_e
+		err    error
+	}{
+		{id: 1, input: "odd-r", expect: hexg.OddR},
+		{id: 2, input: "EVEN-R", expect: hexg.EvenR},
+		{id: 3, input: " odd_q ", expect: hexg.OddQ},
+		{id: 4, input: "Even-Q", expect: hexg.EvenQ},
+		{id: 5, input: "odd", err: hexg.ErrInvalidLayoutOffset},
+		{id: 6, input: "", err: hexg.ErrInvalidLayoutOffset},
+	} {
+		got, err := hexg.ParseLayoutOffset(tc.input)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%d: %q: error: got %v, want %v\n", tc.id, tc.input, err, tc.err)
+		} else if err == nil && got != tc.expect {
+			t.Errorf("%d: %q: got %q, want %q\n", tc.id, tc.input, got, tc.expect)
+		}
+		// every valid offset round-trips through String
+		if err == nil && got.IsValid() {
+			if again, err := hexg.ParseLayoutOffset(got.String()); err != nil || again != got {
+				t.Errorf("%d: %q: round-trip: got %q %v, want %q\n", tc.id, tc.input, again, err, got)
+			}
+		}
+	}
+	if hexg.LayoutOffset_e(7).IsValid() {
+		t.Errorf("valid: 7: got true, want false\n")
+	}
+	// an invalid offset prints a placeholder that doesn't parse
+	if got, want := fmt.Sprintf("%v", hexg.LayoutOffset_e(7)), "LayoutOffset_e(7)"; got != want {
+		t.Errorf("string: 7: got %q, want %q\n", got, want)
+	}
+	if _, err := hexg.ParseLayoutOffset(hexg.LayoutOffset_e(-1).String()); !errors.Is(err, hexg.ErrInvalidLayoutOffset) {
+		t.Errorf("string: -1: round-trip: got %v, want %v\n", err, hexg.ErrInvalidLayoutOffset)
+	}
+}
+
+func TestParseBearing(t *testing.T) {
+	flat := hexg.NewVerticalOddQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	tn := hexg.NewTribeNetLayout()
+	for _, tc := range []struct {
+		id        int
+		l         hexg.Layout_i
+		input     string
+		direction int
+		err       error
+	}{
+		{id: 1, l: flat, input: "N", direction: hexg.N},
+		{id: 2, l: flat, input: "ene", direction: hexg.ENE},
+		{id: 3, l: flat, input: " Wsw", direction: hexg.WSW},
+		{id: 4, l: flat, input: "NNE", err: hexg.ErrInvalidBearing}, // pointy-top only
+		{id: 5, l: flat, input: "E", err: hexg.ErrInvalidBearing},   // pointy-top only
+		{id: 6, l: flat, input: "", err: hexg.ErrInvalidBearing},
+		{id: 7, l: tn, input: "ne", direction: hexg.TNNorthEast},
+		{id: 8, l: tn, input: "SW", direction: hexg.TNSouthWest},
+		{id: 9, l: tn, input: "ENE", err: hexg.ErrInvalidBearing},
+	} {
+		got, err := hexg.ParseBearing(tc.l, tc.input)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%d: %q: error: got %v, want %v\n", tc.id, tc.input, err, tc.err)
+		} else if err == nil && got != tc.direction {
+			t.Errorf("%d: %q: got %d, want %d\n", tc.id, tc.input, got, tc.direction)
+		}
+	}
+}
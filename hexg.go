@@ -9,8 +9,11 @@
 package hexg
 
 import (
+	"cmp"
 	"fmt"
 	"math"
+	"slices"
+	"strings"
 )
 
 // types
@@ -89,6 +92,15 @@ func NewHex(q, r, s int) Hex {
 	return Hex{q: q, r: r, s: s}
 }
 
+// TryNewHex returns a Hex initialized with Cube coordinates.
+// Returns ErrInvalidHex if q + r + s is not zero.
+func TryNewHex(q, r, s int) (Hex, error) {
+	if q+r+s != 0 {
+		return Hex{}, fmt.Errorf("%d,%d,%d: %w", q, r, s, ErrInvalidHex)
+	}
+	return Hex{q: q, r: r, s: s}, nil
+}
+
 // NewHexFromAxialCoords returns a Hex initialized with Axial coordinates.
 // Computes s from q and s, so will never panic on inputs.
 func NewHexFromAxialCoords(q, r int) Hex {
@@ -167,6 +179,26 @@ func Direction(direction int) Hex {
 	return hex_directions[(6+(direction%6))%6]
 }
 
+// 1.3.2 Diagonals
+
+// DiagonalNeighbor returns the hex that is one diagonal step away in the given direction.
+// Diagonal direction k lies between directions k and k+1.
+// Direction is coerced to the range 0..5.
+func (h Hex) DiagonalNeighbor(direction int) Hex {
+	return h.Add(Diagonal(direction))
+}
+
+// hex_diagonals has the offset to the diagonal hex indexed by direction 0..5
+var hex_diagonals = [6]Hex{
+	{2, -1, -1}, {1, -2, 1}, {-1, -1, 2},
+	{-2, 1, 1}, {-1, 2, -1}, {1, 1, -2},
+}
+
+// Diagonal returns the q, r, and s offsets to use based on the diagonal direction
+func Diagonal(direction int) Hex {
+	return hex_diagonals[(6+(direction%6))%6]
+}
+
 // 2.0 Layout
 
 // there really are only two orientations - pointy top and flat top.
@@ -536,14 +568,42 @@ func (h Hex) Hash() uint64 {
 // var heights map[uint64]float64
 // heights[new_hex(1, -2, 3).Hash()] = 4.3
 
+// Add adds the hex to the store.
+func (gs GridStore) Add(h Hex) {
+	gs[h.Hash()] = h
+}
+
+// Contains returns true if the hex is in the store.
+func (gs GridStore) Contains(h Hex) bool {
+	_, ok := gs[h.Hash()]
+	return ok
+}
+
+// Hexes returns the hexes in the store sorted by r and then by q.
+// Map iteration order is random; use this when the order matters.
+func (gs GridStore) Hexes() []Hex {
+	hexes := make([]Hex, 0, len(gs))
+	for _, h := range gs {
+		hexes = append(hexes, h)
+	}
+	slices.SortFunc(hexes, compareHexes)
+	return hexes
+}
+
+// compareHexes orders hexes by r and then by q.
+func compareHexes(a, b Hex) int {
+	if a.r != b.r {
+		return cmp.Compare(a.r, b.r)
+	}
+	return cmp.Compare(a.q, b.q)
+}
+
 // 4.2 Map shapes
 
 // 4.2.1 Parallelograms
 
 // ParallelogramGrid returns a grid originating at (0,0,0).
-// the internal logic depends on the orientation of the grid.
-// I don't understand the comment in the source about there
-// being three coordinates and the caller has to choose two.
+// It bounds q and r; see Parallelogram for the other two pairs of axes.
 func (layout Layout) ParallelogramGrid(q1, r1, q2, r2 int) GridStore {
 	gs := GridStore{}
 	if layout.IsPointyTop() {
@@ -568,9 +628,8 @@ func (layout Layout) ParallelogramGrid(q1, r1, q2, r2 int) GridStore {
 
 // TriagonalGrid returns a grid originating at (0,0,0).
 // the internal logic depends on the orientation of the grid.
-// there's a comment in the source about flipping the y-axis to
-// change the direction of the triangle, but I don't understand
-// how to implement that.
+// Pointy-top layouts get TriangleDown and flat-top layouts get
+// TriangleUp; see Triangle for both directions with an anchor.
 func (layout Layout) TriagonalGrid(side_length int) GridStore {
 	gs := GridStore{}
 	map_size := side_length
@@ -615,6 +674,9 @@ func HexagonalGrid(radius int) GridStore {
 
 // RectangularGrid returns a grid centered about (0,0,0).
 // the internal logic depends on the orientation of the grid.
+// The stagger is always q>>1 or r>>1, which matches odd-q and odd-r
+// layouts but not even-q and even-r. Use OffsetRect to line up with
+// the offset coordinates of the layout.
 func (layout Layout) RectangularGrid(left, right, top, bottom int) GridStore {
 	gs := GridStore{}
 	if layout.IsPointyTop() {
@@ -638,20 +700,46 @@ func (layout Layout) RectangularGrid(left, right, top, bottom int) GridStore {
 	return gs
 }
 
+// OffsetRect returns the hexes whose offset coordinates are in the rectangle.
+// The bounds are inclusive and follow the offset type of the layout,
+// so the grid lines up with HexFromOffsetColRow for all four offset types.
+func (layout Layout) OffsetRect(colMin, colMax, rowMin, rowMax int) GridStore {
+	return offsetRect(layout.HexFromOffsetColRow, colMin, colMax, rowMin, rowMax)
+}
+
 // 4.3 Optimized storage
 
 // todo: translate the template RectangularPointTopMap
 
 // 5.0 Rotation
 
+// RotateLeft returns the hex rotated 60° counter-clockwise around the origin.
 func (h Hex) RotateLeft() Hex {
 	return Hex{q: -h.s, r: -h.q, s: -h.r}
 }
 
+// RotateRight returns the hex rotated 60° clockwise around the origin.
 func (h Hex) RotateRight() Hex {
 	return Hex{q: -h.r, r: -h.s, s: -h.q}
 }
 
+// 5.1 Reflection
+
+// ReflectQ returns the hex reflected across the q axis, which keeps q and swaps r and s.
+func (h Hex) ReflectQ() Hex {
+	return Hex{q: h.q, r: h.s, s: h.r}
+}
+
+// ReflectR returns the hex reflected across the r axis, which keeps r and swaps q and s.
+func (h Hex) ReflectR() Hex {
+	return Hex{q: h.s, r: h.r, s: h.q}
+}
+
+// ReflectS returns the hex reflected across the s axis, which keeps s and swaps q and r.
+func (h Hex) ReflectS() Hex {
+	return Hex{q: h.r, r: h.q, s: h.s}
+}
+
 // 6.0 Offset coordinates
 
 // From the source:
@@ -699,7 +787,7 @@ func (layout Layout) HexFromOffsetColRow(col, row int) Hex {
 		q, r := col, row-(col+EVEN*(col&1))/2
 		return Hex{q: q, r: r, s: -q - r}
 	case odd_q: // flat-top, vertical layout, shoves odd columns down
-		q, r := col, row-(col+EVEN*(col&1))/2
+		q, r := col, row-(col+ODD*(col&1))/2
 		return Hex{q: q, r: r, s: -q - r}
 	case even_r: // pointy-top, horizontal layout, shoves even rows right
 		q, r := col-(row+EVEN*(row&1))/2, row
@@ -722,6 +810,9 @@ const (
 	EvenQ
 )
 
+// String implements the Stringer interface.
+// Values that are not valid return "LayoutOffset_e(n)" rather than panicking;
+// use IsValid or ParseLayoutOffset to check a value.
 func (e LayoutOffset_e) String() string {
 	switch e {
 	case OddR:
@@ -732,9 +823,31 @@ func (e LayoutOffset_e) String() string {
 		return "odd-q"
 	case EvenQ:
 		return "even-q"
-	default:
-		panic(fmt.Sprintf("assert(e != %d)", e))
 	}
+	return fmt.Sprintf("LayoutOffset_e(%d)", int(e))
+}
+
+// IsValid returns true if the value is one of the four types of offset.
+func (e LayoutOffset_e) IsValid() bool {
+	return OddR <= e && e <= EvenQ
+}
+
+// ParseLayoutOffset returns the offset type for a name returned by LayoutOffset_e.String.
+// The name is not case-sensitive and may use an underscore instead of a hyphen,
+// so "odd-q", "ODD-Q", and "odd_q" are all accepted.
+// Returns ErrInvalidLayoutOffset for other names.
+func ParseLayoutOffset(name string) (LayoutOffset_e, error) {
+	switch strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "_", "-") {
+	case "odd-r":
+		return OddR, nil
+	case "even-r":
+		return EvenR, nil
+	case "odd-q":
+		return OddQ, nil
+	case "even-q":
+		return EvenQ, nil
+	}
+	return 0, fmt.Errorf("%q: %w", name, ErrInvalidLayoutOffset)
 }
 
 type layoutOffset int
@@ -959,6 +1072,23 @@ func BearingToDirection(p string) int {
 	return dir
 }
 
+// ParseBearing returns the direction for a bearing in the layout.
+//
+// The bearing is not case-sensitive. It must be one of the names returned by
+// the layout's DirectionToBearing, so bearings that are not supported by the
+// layout (see the table above) are rejected. For example, "N" is accepted
+// for flat-top layouts but not for pointy-top layouts.
+// Returns ErrInvalidBearing for other bearings.
+func ParseBearing(l Layout_i, bearing string) (int, error) {
+	p := strings.ToUpper(strings.TrimSpace(bearing))
+	for direction := 0; direction < 6; direction++ {
+		if l.DirectionToBearing(direction) == p {
+			return direction, nil
+		}
+	}
+	return 0, fmt.Errorf("%q: %w", bearing, ErrInvalidBearing)
+}
+
 var (
 	// horizontalDirectionToBearing maps a direction to the compass point for a horizontal layout
 	horizontalDirectionToBearing = []string{
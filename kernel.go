@@ -0,0 +1,199 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"math"
+	"sync"
+)
+
+// Kernel is a set of weights for the hexes around a center.
+// The hexes are relative to the origin, which is the center.
+type Kernel Grid[float64]
+
+// NewKernel returns a kernel with a weight for every hex within the radius of the origin.
+func NewKernel(radius int, weight func(offset Hex) float64) Kernel {
+	k := Kernel{}
+	for _, h := range HexagonalGrid(radius) {
+		k[h.Hash()] = Cell[float64]{Hex: h, Value: weight(h)}
+	}
+	return k
+}
+
+// UniformKernel returns a kernel with a weight of 1 for every hex within the radius.
+// With KernelOptions.Normalize, it is a mean filter.
+func UniformKernel(radius int) Kernel {
+	return NewKernel(radius, func(Hex) float64 { return 1 })
+}
+
+// GaussianKernel returns a kernel whose weights fall off with the distance
+// from the origin like a normal distribution with the standard deviation sigma.
+// With KernelOptions.Normalize, it is a blur.
+func GaussianKernel(radius int, sigma float64) Kernel {
+	return NewKernel(radius, func(offset Hex) float64 {
+		d := float64(offset.Length())
+		return math.Exp(-d * d / (2 * sigma * sigma))
+	})
+}
+
+// Cells returns the cells in the kernel sorted by r and then by q.
+func (k Kernel) Cells() []Cell[float64] {
+	return Grid[float64](k).Cells()
+}
+
+// EdgeMode_e is the rule for the neighbors of a hex that are not in the grid.
+type EdgeMode_e int
+
+const (
+	// EdgeSkip leaves out the neighbors that are not in the grid.
+	EdgeSkip EdgeMode_e = iota
+	// EdgeClamp uses the value of the nearest hex that is in the grid on
+	// the line from the neighbor back to the center.
+	EdgeClamp
+	// EdgeConstant uses KernelOptions.Constant for the neighbors that are not in the grid.
+	EdgeConstant
+	// EdgeWrap uses KernelOptions.Wrap to find the neighbor on the map.
+	// Neighbors that are still not in the grid after wrapping are left out.
+	EdgeWrap
+)
+
+// String implements the Stringer interface.
+func (e EdgeMode_e) String() string {
+	switch e {
+	case EdgeSkip:
+		return "skip"
+	case EdgeClamp:
+		return "clamp"
+	case EdgeConstant:
+		return "constant"
+	case EdgeWrap:
+		return "wrap"
+	}
+	return "?"
+}
+
+// KernelOptions are the options for Convolve and Filter.
+type KernelOptions[T any] struct {
+	// Edges is the rule for neighbors that are not in the grid.
+	Edges EdgeMode_e
+
+	// Constant is the value of neighbors that are not in the grid when Edges is EdgeConstant.
+	Constant T
+
+	// Wrap returns the hex on the map for a neighbor that may be off the map
+	// when Edges is EdgeWrap; see WrapColumns.
+	Wrap func(h Hex) Hex
+
+	// Normalize divides the result of Convolve by the sum of the weights that
+	// were used, so a blur is still a weighted mean where neighbors were skipped.
+	// It is ignored by Filter.
+	Normalize bool
+
+	// Workers is the number of goroutines that process the grid, each taking
+	// a contiguous chunk of the hexes. Zero or one processes the grid in the
+	// calling goroutine. The result is the same for any number of workers,
+	// but the weight and reduce functions must be safe to call concurrently.
+	Workers int
+}
+
+// sample returns the value for the hex at the offset from the center.
+// Ok is false if the neighbor is left out under the edge rule.
+func (opts KernelOptions[T]) sample(g Grid[T], center, offset Hex) (value T, ok bool) {
+	h := center.Add(offset)
+	if value, ok = g.Get(h); ok {
+		return value, true
+	}
+	switch opts.Edges {
+	case EdgeClamp:
+		// the line starts at the center, which is in the grid
+		line := center.Linedraw(h, true)
+		for i := len(line) - 1; i >= 0; i-- {
+			if value, ok = g.Get(line[i]); ok {
+				return value, true
+			}
+		}
+		return g.Get(center)
+	case EdgeConstant:
+		return opts.Constant, true
+	case EdgeWrap:
+		if opts.Wrap != nil {
+			return g.Get(opts.Wrap(h))
+		}
+	}
+	return value, false
+}
+
+// Convolve returns a grid with the weighted sum of the neighborhood of every
+// hex in the grid. The neighborhood is the hexes under the kernel when its
+// origin is on the hex.
+//
+// Values are added in the order of Kernel.Cells, so the result is the same
+// from one run to the next.
+func Convolve(g Grid[float64], k Kernel, opts KernelOptions[float64]) Grid[float64] {
+	weights := k.Cells()
+	return applyKernel(g, opts.Workers, func(h Hex) float64 {
+		var sum, total float64
+		for _, w := range weights {
+			if value, ok := opts.sample(g, h, w.Hex); ok {
+				sum += w.Value * value
+				total += w.Value
+			}
+		}
+		if opts.Normalize && total != 0 {
+			return sum / total
+		}
+		return sum
+	})
+}
+
+// Filter returns a grid with the result of reducing the neighborhood of every
+// hex in the grid. The neighborhood is the hexes within the radius of the hex.
+// Reducers like Mean, Majority, Min and Max make smoothing, majority vote,
+// and min/max filters.
+//
+// The values are passed in sorted offset order, so reducers like Majority
+// that depend on the order are deterministic.
+func Filter[T, U any](g Grid[T], radius int, reduce func(values []T) U, opts KernelOptions[T]) Grid[U] {
+	offsets := HexagonalGrid(radius).Hexes()
+	return applyKernel(g, opts.Workers, func(h Hex) U {
+		values := make([]T, 0, len(offsets))
+		for _, offset := range offsets {
+			if value, ok := opts.sample(g, h, offset); ok {
+				values = append(values, value)
+			}
+		}
+		return reduce(values)
+	})
+}
+
+// applyKernel returns a grid with the result of fn for every hex in the grid.
+// The hexes are split into contiguous chunks, one for each worker.
+func applyKernel[T, U any](g Grid[T], workers int, fn func(h Hex) U) Grid[U] {
+	hexes := g.Hexes()
+	values := make([]U, len(hexes))
+	workers = max(1, min(workers, len(hexes)))
+	if workers == 1 {
+		for i, h := range hexes {
+			values[i] = fn(h)
+		}
+	} else {
+		var wg sync.WaitGroup
+		size := (len(hexes) + workers - 1) / workers
+		for start := 0; start < len(hexes); start += size {
+			end := min(start+size, len(hexes))
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := start; i < end; i++ {
+					values[i] = fn(hexes[i])
+				}
+			}()
+		}
+		wg.Wait()
+	}
+	result := make(Grid[U], len(hexes))
+	for i, h := range hexes {
+		result.Set(h, values[i])
+	}
+	return result
+}
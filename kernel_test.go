@@ -0,0 +1,125 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestConvolve(t *testing.T) {
+	ones := hexg.NewGrid(hexg.HexagonalGrid(2), func(hexg.Hex) float64 { return 1 })
+	distances := hexg.NewGrid(hexg.HexagonalGrid(2), func(h hexg.Hex) float64 {
+		return float64(h.Distance(hexg.NewHex(0, 0, 0)))
+	})
+	center, corner, side := hexg.NewHex(0, 0, 0), hexg.NewHex(2, -2, 0), hexg.NewHex(2, -1, -1)
+
+	for _, tc := range []struct {
+		id   int
+		g    hexg.Grid[float64]
+		k    hexg.Kernel
+		opts hexg.KernelOptions[float64]
+		h    hexg.Hex
+		want float64
+	}{
+		// a corner of the hexagon has 3 neighbors in the grid and a side has 4
+		{1, ones, hexg.UniformKernel(1), hexg.KernelOptions[float64]{}, center, 7},
+		{2, ones, hexg.UniformKernel(1), hexg.KernelOptions[float64]{}, corner, 4},
+		{3, ones, hexg.UniformKernel(1), hexg.KernelOptions[float64]{}, side, 5},
+		{4, ones, hexg.UniformKernel(1), hexg.KernelOptions[float64]{Normalize: true}, corner, 1},
+		{5, ones, hexg.UniformKernel(1), hexg.KernelOptions[float64]{Edges: hexg.EdgeConstant, Constant: 10}, corner, 34},
+		{6, ones, hexg.UniformKernel(2), hexg.KernelOptions[float64]{}, center, 19},
+		// the neighbors off the map take the value of the corner itself
+		{7, distances, hexg.UniformKernel(1), hexg.KernelOptions[float64]{Edges: hexg.EdgeClamp}, corner, 2 + 2 + 1 + 2 + 3*2},
+		{8, distances, hexg.UniformKernel(1), hexg.KernelOptions[float64]{Edges: hexg.EdgeClamp, Normalize: true}, center, 6.0 / 7},
+	} {
+		got, ok := hexg.Convolve(tc.g, tc.k, tc.opts).Get(tc.h)
+		if !ok || math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%d: %s: %v: got %v, want %v\n", tc.id, tc.opts.Edges, tc.h, got, tc.want)
+		}
+	}
+}
+
+func TestConvolve_Workers(t *testing.T) {
+	l := hexg.NewTribeNetLayout()
+	terrain := hexg.GenerateTerrain(l, l.OffsetRect(0, 29, 0, 20), hexg.TerrainParams{Seed: 7})
+	elevation := hexg.Grid[float64]{}
+	for _, cell := range terrain.Cells() {
+		elevation.Set(cell.Hex, cell.Value.Elevation)
+	}
+	k := hexg.GaussianKernel(2, 1)
+	want := hexg.Convolve(elevation, k, hexg.KernelOptions[float64]{Normalize: true})
+	for _, workers := range []int{2, 7, 1000} {
+		got := hexg.Convolve(elevation, k, hexg.KernelOptions[float64]{Normalize: true, Workers: workers})
+		if len(got) != len(want) {
+			t.Errorf("workers %d: len: got %d, want %d\n", workers, len(got), len(want))
+		}
+		for key, cell := range want {
+			if got[key] != cell {
+				t.Errorf("workers %d: %v: got %v, want %v\n", workers, cell.Hex, got[key].Value, cell.Value)
+				break
+			}
+		}
+	}
+}
+
+func TestGaussianKernel(t *testing.T) {
+	k := hexg.GaussianKernel(2, 1)
+	if len(k) != 19 {
+		t.Errorf("len: got %d, want 19\n", len(k))
+	}
+	for _, tc := range []struct {
+		id   int
+		h    hexg.Hex
+		want float64
+	}{
+		{1, hexg.NewHex(0, 0, 0), 1},
+		{2, hexg.NewHex(0, -1, 1), math.Exp(-0.5)},
+		{3, hexg.NewHex(2, -1, -1), math.Exp(-2)},
+	} {
+		if got := k[tc.h.Hash()].Value; math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%d: got %v, want %v\n", tc.id, got, tc.want)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	// a 4 by 3 map whose values are the column numbers
+	l := hexg.NewVerticalEvenQLayout(hexg.NewPoint(10, 10), hexg.NewPoint(0, 0))
+	cols := hexg.NewGrid(l.OffsetRect(0, 3, 0, 2), func(h hexg.Hex) int {
+		return l.HexToOffsetCoord(h).Col
+	})
+	wrap := hexg.KernelOptions[int]{Edges: hexg.EdgeWrap, Wrap: hexg.WrapColumns(l, 4)}
+
+	for _, tc := range []struct {
+		id     int
+		reduce func([]int) int
+		opts   hexg.KernelOptions[int]
+		col    int
+		want   int
+	}{
+		{1, hexg.Max[int], hexg.KernelOptions[int]{}, 0, 1},
+		{2, hexg.Max[int], wrap, 0, 3},
+		{3, hexg.Min[int], hexg.KernelOptions[int]{}, 3, 2},
+		{4, hexg.Min[int], wrap, 3, 0},
+		{5, hexg.Max[int], hexg.KernelOptions[int]{Edges: hexg.EdgeConstant, Constant: 9}, 0, 9},
+		{6, hexg.Sum[int], wrap, 1, 1 + 1 + 1 + 0 + 0 + 2 + 2},
+	} {
+		h := l.OffsetColRowToHex(tc.col, 1)
+		if got, _ := hexg.Filter(cols, 1, tc.reduce, tc.opts).Get(h); got != tc.want {
+			t.Errorf("%d: %s: col %d: got %d, want %d\n", tc.id, tc.opts.Edges, tc.col, got, tc.want)
+		}
+	}
+
+	// a majority vote removes a lone hex of one class
+	classes := hexg.NewGrid(hexg.HexagonalGrid(2), func(hexg.Hex) string { return "grass" })
+	classes.Set(hexg.NewHex(1, 0, -1), "rock")
+	smooth := hexg.Filter(classes, 1, hexg.Majority[string], hexg.KernelOptions[string]{})
+	for _, cell := range smooth.Cells() {
+		if cell.Value != "grass" {
+			t.Errorf("majority: %v: got %q, want %q\n", cell.Hex, cell.Value, "grass")
+		}
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LabelScheme converts between hexes and the labels printed on a map.
+//
+// Labels are based on offset coordinates, so every scheme is tied to a layout.
+type LabelScheme interface {
+	// Format returns the label for the hex.
+	// Returns ErrInvalidLabel if the hex can't be labeled by the scheme.
+	Format(h Hex) (string, error)
+
+	// Parse returns the hex for the label.
+	// Returns ErrInvalidLabel if the label isn't valid for the scheme.
+	Parse(label string) (Hex, error)
+}
+
+// SheetScheme labels hexes by sheet and by position on the sheet.
+//
+// The map is cut into sheets that are Cols hexes wide and Rows hexes tall.
+// Sheets are identified by two letters, the sheet row and then the sheet
+// column, and the position on the sheet is the 1-based column and row.
+// Offset coordinate (0,0) is the upper-left hex of sheet "AA".
+//
+// TribeNet uses this scheme with 30x21 sheets, so "AB 0102" is the first
+// column and second row of the sheet in the first row and second column.
+type SheetScheme struct {
+	Layout Layout_i
+
+	// Cols and Rows are the size of a sheet in hexes.
+	Cols, Rows int
+
+	// Sheets is the number of sheets in each direction.
+	// It must not be more than 26, since sheets are lettered A to Z.
+	Sheets int
+
+	// Digits is the number of digits for the column and for the row.
+	Digits int
+
+	// Separator is printed between the sheet letters and the position.
+	Separator string
+}
+
+// check returns ErrInvalidLabel if the scheme is not configured, so that a
+// zero value returns an error instead of dividing by zero.
+func (s SheetScheme) check() error {
+	if s.Cols < 1 || s.Rows < 1 {
+		return fmt.Errorf("sheet scheme: cols, rows: %d, %d: must be positive: %w", s.Cols, s.Rows, ErrInvalidLabel)
+	} else if s.Sheets < 1 || s.Sheets > 26 {
+		return fmt.Errorf("sheet scheme: sheets: %d: must be 1 to 26: %w", s.Sheets, ErrInvalidLabel)
+	} else if s.Digits < 1 {
+		return fmt.Errorf("sheet scheme: digits: %d: must be positive: %w", s.Digits, ErrInvalidLabel)
+	}
+	return nil
+}
+
+// Format returns the label for the hex.
+func (s SheetScheme) Format(h Hex) (string, error) {
+	if s.Layout == nil {
+		return "", fmt.Errorf("sheet scheme: missing layout: %w", ErrInvalidLabel)
+	}
+	oc := s.Layout.HexToOffsetCoord(h)
+	return s.FormatColRow(oc.Col, oc.Row)
+}
+
+// FormatColRow returns the label for the offset column and row.
+func (s SheetScheme) FormatColRow(col, row int) (string, error) {
+	if err := s.check(); err != nil {
+		return "", err
+	} else if col < 0 || row < 0 {
+		return "", fmt.Errorf("invalid col, row: %d, %d: %w", col, row, ErrInvalidLabel)
+	}
+	sheetRow, sheetCol := row/s.Rows, col/s.Cols
+	if sheetRow >= s.Sheets || sheetCol >= s.Sheets {
+		return "", fmt.Errorf("col, row: %d, %d: out of range for A-%c sheets: %w", col, row, 'A'+rune(s.Sheets-1), ErrInvalidLabel)
+	}
+	subCol, subRow := col%s.Cols, row%s.Rows
+	if len(strconv.Itoa(subCol+1)) > s.Digits || len(strconv.Itoa(subRow+1)) > s.Digits {
+		return "", fmt.Errorf("col, row: %d, %d: out of range for %d digits: %w", col, row, s.Digits, ErrInvalidLabel)
+	}
+	// translate the position by (+1,+1) since sheets are 1-based
+	return fmt.Sprintf("%c%c%s%0*d%0*d", 'A'+rune(sheetRow), 'A'+rune(sheetCol), s.Separator, s.Digits, subCol+1, s.Digits, subRow+1), nil
+}
+
+// Parse returns the hex for the label.
+func (s SheetScheme) Parse(label string) (Hex, error) {
+	if s.Layout == nil {
+		return Hex{}, fmt.Errorf("sheet scheme: missing layout: %w", ErrInvalidLabel)
+	}
+	col, row, err := s.ParseColRow(label)
+	if err != nil {
+		return Hex{}, err
+	}
+	return s.Layout.OffsetColRowToHex(col, row), nil
+}
+
+// ParseColRow returns the offset column and row for the label.
+func (s SheetScheme) ParseColRow(label string) (col, row int, err error) {
+	if err := s.check(); err != nil {
+		return 0, 0, err
+	} else if len(label) != 2+len(s.Separator)+2*s.Digits || label[2:2+len(s.Separator)] != s.Separator {
+		return 0, 0, fmt.Errorf("%q: expected 'AB%s%0*d%0*d': %w", label, s.Separator, s.Digits, 1, s.Digits, 2, ErrInvalidLabel)
+	}
+	sheetRow, sheetCol := int(label[0])-'A', int(label[1])-'A'
+	if !(0 <= sheetRow && sheetRow < s.Sheets) {
+		return 0, 0, fmt.Errorf("%q: invalid sheet row: must be A-%c: %w", label, 'A'+rune(s.Sheets-1), ErrInvalidLabel)
+	} else if !(0 <= sheetCol && sheetCol < s.Sheets) {
+		return 0, 0, fmt.Errorf("%q: invalid sheet column: must be A-%c: %w", label, 'A'+rune(s.Sheets-1), ErrInvalidLabel)
+	}
+
+	position := label[2+len(s.Separator):]
+	subCol, ok := parseDigits(position[:s.Digits])
+	if !ok || !(1 <= subCol && subCol <= s.Cols) {
+		return 0, 0, fmt.Errorf("%q: invalid sheet column: %s: %w", label, position[:s.Digits], ErrInvalidLabel)
+	}
+	subRow, ok := parseDigits(position[s.Digits:])
+	if !ok || !(1 <= subRow && subRow <= s.Rows) {
+		return 0, 0, fmt.Errorf("%q: invalid sheet row: %s: %w", label, position[s.Digits:], ErrInvalidLabel)
+	}
+
+	// translate by (-1,-1) to shift the origin back to (0,0)
+	return sheetCol*s.Cols + subCol - 1, sheetRow*s.Rows + subRow - 1, nil
+}
+
+// NumberScheme labels hexes with the column number followed by the row number,
+// like "0102" for the first column and second row. This is the scheme used by
+// most board wargames.
+type NumberScheme struct {
+	Layout Layout_i
+
+	// Digits is the number of digits for the column and for the row.
+	Digits int
+
+	// First is the number of the first column and row, usually 1.
+	// Offset coordinate (0,0) is labeled with First for both the column and the row.
+	// Columns and rows before the first are off the map and have no label.
+	First int
+}
+
+// Format returns the label for the hex.
+func (s NumberScheme) Format(h Hex) (string, error) {
+	if s.Layout == nil {
+		return "", fmt.Errorf("number scheme: missing layout: %w", ErrInvalidLabel)
+	}
+	oc := s.Layout.HexToOffsetCoord(h)
+	col, row := oc.Col+s.First, oc.Row+s.First
+	if oc.Col < 0 || oc.Row < 0 || col < 0 || row < 0 || len(strconv.Itoa(col)) > s.Digits || len(strconv.Itoa(row)) > s.Digits {
+		return "", fmt.Errorf("col, row: %d, %d: out of range for %d digits: %w", oc.Col, oc.Row, s.Digits, ErrInvalidLabel)
+	}
+	return fmt.Sprintf("%0*d%0*d", s.Digits, col, s.Digits, row), nil
+}
+
+// Parse returns the hex for the label.
+func (s NumberScheme) Parse(label string) (Hex, error) {
+	if s.Layout == nil {
+		return Hex{}, fmt.Errorf("number scheme: missing layout: %w", ErrInvalidLabel)
+	}
+	if len(label) != 2*s.Digits {
+		return Hex{}, fmt.Errorf("%q: expected %d digits: %w", label, 2*s.Digits, ErrInvalidLabel)
+	}
+	col, ok := parseDigits(label[:s.Digits])
+	if !ok || col < s.First {
+		return Hex{}, fmt.Errorf("%q: invalid column: %w", label, ErrInvalidLabel)
+	}
+	row, ok := parseDigits(label[s.Digits:])
+	if !ok || row < s.First {
+		return Hex{}, fmt.Errorf("%q: invalid row: %w", label, ErrInvalidLabel)
+	}
+	return s.Layout.OffsetColRowToHex(col-s.First, row-s.First), nil
+}
+
+// ChessScheme labels hexes with letters for the column and a number for the
+// row, like "C7" for the third column and the seventh row. Columns after "Z"
+// are "AA", "AB", and so on, like the columns of a spreadsheet.
+type ChessScheme struct {
+	Layout Layout_i
+
+	// First is the number of the first row, usually 1.
+	// Offset coordinate (0,0) is labeled "A" followed by First.
+	// Rows before the first are off the map and have no label.
+	First int
+}
+
+// Format returns the label for the hex.
+func (s ChessScheme) Format(h Hex) (string, error) {
+	if s.Layout == nil {
+		return "", fmt.Errorf("chess scheme: missing layout: %w", ErrInvalidLabel)
+	}
+	oc := s.Layout.HexToOffsetCoord(h)
+	if oc.Col < 0 || oc.Row < 0 || oc.Row+s.First < 0 {
+		return "", fmt.Errorf("col, row: %d, %d: out of range: %w", oc.Col, oc.Row, ErrInvalidLabel)
+	}
+	// bijective base-26, so column 26 is "AA" and not "BA"
+	var letters []byte
+	for n := oc.Col + 1; n > 0; n = (n - 1) / 26 {
+		letters = append([]byte{byte('A' + (n-1)%26)}, letters...)
+	}
+	return fmt.Sprintf("%s%d", letters, oc.Row+s.First), nil
+}
+
+// Parse returns the hex for the label.
+// Column letters are not case-sensitive.
+func (s ChessScheme) Parse(label string) (Hex, error) {
+	if s.Layout == nil {
+		return Hex{}, fmt.Errorf("chess scheme: missing layout: %w", ErrInvalidLabel)
+	}
+	upper := strings.ToUpper(label)
+	n := 0
+	for n < len(upper) && 'A' <= upper[n] && upper[n] <= 'Z' {
+		n++
+	}
+	if n == 0 || n > 6 {
+		return Hex{}, fmt.Errorf("%q: invalid column: %w", label, ErrInvalidLabel)
+	}
+	row, ok := parseDigits(upper[n:])
+	if !ok || row < s.First {
+		return Hex{}, fmt.Errorf("%q: invalid row: %w", label, ErrInvalidLabel)
+	}
+	col := 0
+	for i := 0; i < n; i++ {
+		col = col*26 + int(upper[i]-'A') + 1
+	}
+	return s.Layout.OffsetColRowToHex(col-1, row-s.First), nil
+}
+
+// parseDigits returns the value of a string that contains only decimal digits.
+// Unlike strconv.Atoi, it rejects signs and spaces.
+func parseDigits(digits string) (int, bool) {
+	if digits == "" || len(digits) > 9 {
+		return 0, false
+	}
+	value := 0
+	for i := 0; i < len(digits); i++ {
+		if !('0' <= digits[i] && digits[i] <= '9') {
+			return 0, false
+		}
+		value = value*10 + int(digits[i]-'0')
+	}
+	return value, true
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestLabelScheme_RoundTrip(t *testing.T) {
+	l := hexg.NewVerticalEvenQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	tn := hexg.NewTribeNetLayout()
+
+	for _, tc := range []struct {
+		id     int
+		scheme hexg.LabelScheme
+		col    int
+		row    int
+		expect string
+	}{
+		{id: 1, scheme: tn.LabelScheme(), col: 0, row: 0, expect: "AA 0101"},
+		{id: 2, scheme: tn.LabelScheme(), col: 61, row: 43, expect: "CC 0202"},
+		{id: 3, scheme: tn.LabelScheme(), col: 779, row: 545, expect: "ZZ 3021"},
+		{id: 4, scheme: hexg.SheetScheme{Layout: l, Cols: 10, Rows: 8, Sheets: 4, Digits: 2, Separator: "-"}, col: 12, row: 3, expect: "AB-0304"},
+		{id: 5, scheme: hexg.SheetScheme{Layout: l, Cols: 9, Rows: 8, Sheets: 4, Digits: 1, Separator: ""}, col: 35, row: 31, expect: "DD98"},
+		{id: 6, scheme: hexg.NumberScheme{Layout: l, Digits: 2, First: 1}, col: 0, row: 1, expect: "0102"},
+		{id: 7, scheme: hexg.NumberScheme{Layout: l, Digits: 2, First: 0}, col: 12, row: 7, expect: "1207"},
+		{id: 8, scheme: hexg.NumberScheme{Layout: l, Digits: 3, First: 1}, col: 99, row: 0, expect: "100001"},
+		{id: 9, scheme: hexg.ChessScheme{Layout: l, First: 1}, col: 2, row: 6, expect: "C7"},
+		{id: 10, scheme: hexg.ChessScheme{Layout: l, First: 1}, col: 25, row: 0, expect: "Z1"},
+		{id: 11, scheme: hexg.ChessScheme{Layout: l, First: 1}, col: 26, row: 9, expect: "AA10"},
+		{id: 12, scheme: hexg.ChessScheme{Layout: l, First: 0}, col: 52, row: 0, expect: "BA0"},
+	} {
+		var h hexg.Hex
+		if sheet, ok := tc.scheme.(hexg.SheetScheme); ok {
+			h = sheet.Layout.OffsetColRowToHex(tc.col, tc.row)
+		} else {
+			h = l.OffsetColRowToHex(tc.col, tc.row)
+		}
+		label, err := tc.scheme.Format(h)
+		if err != nil {
+			t.Errorf("%d: format: error %v\n", tc.id, err)
+			continue
+		} else if label != tc.expect {
+			t.Errorf("%d: format: got %q, want %q\n", tc.id, label, tc.expect)
+		}
+		got, err := tc.scheme.Parse(tc.expect)
+		if err != nil {
+			t.Errorf("%d: parse: error %v\n", tc.id, err)
+		} else if got != h {
+			t.Errorf("%d: parse: got %v, want %v\n", tc.id, got, h)
+		}
+	}
+}
+
+func TestLabelScheme_Invalid(t *testing.T) {
+	l := hexg.NewVerticalEvenQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	tn := hexg.NewTribeNetLayout().LabelScheme()
+	number := hexg.NumberScheme{Layout: l, Digits: 2, First: 1}
+	chess := hexg.ChessScheme{Layout: l, First: 1}
+
+	for _, tc := range []struct {
+		id     int
+		scheme hexg.LabelScheme
+		input  string
+	}{
+		{id: 1, scheme: tn, input: "AB0102"},
+		{id: 2, scheme: tn, input: "ab 0102"},
+		{id: 3, scheme: tn, input: "AB 3101"},
+		{id: 4, scheme: tn, input: "AB 0122"},
+		{id: 5, scheme: tn, input: "AB +102"},
+		{id: 6, scheme: number, input: "102"},
+		{id: 7, scheme: number, input: "01x2"},
+		{id: 8, scheme: chess, input: "7C"},
+		{id: 9, scheme: chess, input: "C"},
+		{id: 10, scheme: chess, input: "C-7"},
+		// labels before the first column or row are off the map
+		{id: 11, scheme: number, input: "0000"},
+		{id: 12, scheme: number, input: "0100"},
+		{id: 13, scheme: chess, input: "C0"},
+	} {
+		if _, err := tc.scheme.Parse(tc.input); !errors.Is(err, hexg.ErrInvalidLabel) {
+			t.Errorf("%d: parse %q: got %v, want %v\n", tc.id, tc.input, err, hexg.ErrInvalidLabel)
+		}
+	}
+
+	// offsets before the first column or row are off the map
+	for _, h := range []hexg.Hex{l.OffsetColRowToHex(-2, 0), l.OffsetColRowToHex(-1, -1), l.OffsetColRowToHex(0, -1)} {
+		if _, err := number.Format(h); !errors.Is(err, hexg.ErrInvalidLabel) {
+			t.Errorf("number: format %v: got %v, want %v\n", h, err, hexg.ErrInvalidLabel)
+		}
+		if _, err := chess.Format(h); !errors.Is(err, hexg.ErrInvalidLabel) {
+			t.Errorf("chess: format %v: got %v, want %v\n", h, err, hexg.ErrInvalidLabel)
+		}
+	}
+
+	// one digit can't hold the tenth column of a sheet
+	sheet := hexg.SheetScheme{Layout: l, Cols: 10, Rows: 8, Sheets: 4, Digits: 1}
+	if _, err := sheet.Format(l.OffsetColRowToHex(9, 0)); !errors.Is(err, hexg.ErrInvalidLabel) {
+		t.Errorf("sheet: format: got %v, want %v\n", err, hexg.ErrInvalidLabel)
+	}
+
+	// schemes that aren't configured return errors instead of panicking
+	for _, tc := range []struct {
+		id     int
+		scheme hexg.SheetScheme
+	}{
+		{id: 1, scheme: hexg.SheetScheme{}},
+		{id: 2, scheme: hexg.SheetScheme{Layout: l}},
+		{id: 3, scheme: hexg.SheetScheme{Layout: l, Cols: 10, Sheets: 4, Digits: 2}},
+		{id: 4, scheme: hexg.SheetScheme{Layout: l, Cols: 10, Rows: 8, Digits: 2}},
+		{id: 5, scheme: hexg.SheetScheme{Layout: l, Cols: 10, Rows: 8, Sheets: 27, Digits: 2}},
+		{id: 6, scheme: hexg.SheetScheme{Layout: l, Cols: 10, Rows: 8, Sheets: 4}},
+	} {
+		if _, err := tc.scheme.Format(l.OffsetColRowToHex(1, 1)); !errors.Is(err, hexg.ErrInvalidLabel) {
+			t.Errorf("unconfigured %d: format: got %v, want %v\n", tc.id, err, hexg.ErrInvalidLabel)
+		}
+		if _, err := tc.scheme.FormatColRow(1, 1); !errors.Is(err, hexg.ErrInvalidLabel) {
+			t.Errorf("unconfigured %d: format col, row: got %v, want %v\n", tc.id, err, hexg.ErrInvalidLabel)
+		}
+		if _, err := tc.scheme.Parse("AA0202"); !errors.Is(err, hexg.ErrInvalidLabel) {
+			t.Errorf("unconfigured %d: parse: got %v, want %v\n", tc.id, err, hexg.ErrInvalidLabel)
+		}
+	}
+	for _, tc := range []struct {
+		id     int
+		scheme hexg.LabelScheme
+		input  string
+	}{
+		{id: 1, scheme: hexg.NumberScheme{}, input: ""},
+		{id: 2, scheme: hexg.NumberScheme{Digits: 2, First: 1}, input: "0102"},
+		{id: 3, scheme: hexg.ChessScheme{}, input: "C7"},
+		{id: 4, scheme: hexg.ChessScheme{First: 1}, input: "C7"},
+	} {
+		if _, err := tc.scheme.Format(hexg.Hex{}); !errors.Is(err, hexg.ErrInvalidLabel) {
+			t.Errorf("missing layout %d: format: got %v, want %v\n", tc.id, err, hexg.ErrInvalidLabel)
+		}
+		if _, err := tc.scheme.Parse(tc.input); !errors.Is(err, hexg.ErrInvalidLabel) {
+			t.Errorf("missing layout %d: parse: got %v, want %v\n", tc.id, err, hexg.ErrInvalidLabel)
+		}
+	}
+}
+
+func TestLayoutHex_WithLabels(t *testing.T) {
+	l := hexg.NewVerticalOddQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	h := l.OffsetColRowToHex(2, 6)
+
+	for _, tc := range []struct {
+		id     int
+		value  hexg.LayoutHex
+		expect string
+	}{
+		{id: 1, value: h.In(l), expect: "2,6"},
+		{id: 2, value: h.In(l).WithLabels(hexg.ChessScheme{Layout: l, First: 1}), expect: "C7"},
+		{id: 3, value: h.In(l).WithLabels(hexg.NumberScheme{Layout: l, Digits: 2, First: 1}), expect: "0307"},
+		{id: 4, value: h.In(hexg.NewTribeNetLayout()), expect: "AA 0307"},
+	} {
+		if got := fmt.Sprintf("%L", tc.value); got != tc.expect {
+			t.Errorf("%d: %%L: got %q, want %q\n", tc.id, got, tc.expect)
+		}
+	}
+}
@@ -45,15 +45,29 @@ type Layout_i interface {
 	// OffsetCoordToHex returns a new Hex from the OffsetCoord.
 	OffsetCoordToHex(oc OffsetCoord) Hex
 
+	// OffsetRect returns the hexes whose offset coordinates are in the rectangle.
+	// The bounds are inclusive. A hex is in the grid exactly when HexToOffsetCoord
+	// returns a column in colMin..colMax and a row in rowMin..rowMax.
+	OffsetRect(colMin, colMax, rowMin, rowMax int) GridStore
+
 	// ParallelogramGrid returns a grid originating at (0,0,0).
-	// I don't understand the comment in the source about there
-	// being three coordinates and the caller has to choose two.
-	// does that mean the grid has three orientations?
+	// It bounds q to q1..q2 and r to r1..r2. Picking two other axes gives
+	// the other two orientations; see Parallelogram for all three.
 	ParallelogramGrid(q1, r1, q2, r2 int) GridStore
 
+	// PixelToEdge returns the edge nearest to the pixel and the distance to it in pixels.
+	// Ok is false when the distance is greater than the tolerance,
+	// meaning that the pixel is in the interior of the hex.
+	PixelToEdge(p Point, tolerance float64) (e Edge, distance float64, ok bool)
+
 	// PixelToHexRounded turns a fractional hex into a regular hex coordinate:
 	PixelToHexRounded(p Point) Hex
 
+	// PixelToVertex returns the vertex nearest to the pixel and the distance to it in pixels.
+	// Ok is false when the distance is greater than the tolerance,
+	// meaning that the pixel is in the interior of the hex.
+	PixelToVertex(p Point, tolerance float64) (v Vertex, distance float64, ok bool)
+
 	// PixelToFractionalHex returns the fractional hex that encloses the pixel.
 	// In theory, the origin of that fractional hex will be the pixel.
 	PixelToFractionalHex(p Point) FractionalHex
@@ -66,16 +80,72 @@ type Layout_i interface {
 	PolygonCornerOffsets() [6]Point
 
 	// RectangularGrid returns a grid centered about a hex.
+	// It ignores the parity of the layout; use OffsetRect to line up with offset coordinates.
 	RectangularGrid(center Hex, left, right, top, bottom int) GridStore
 
 	// TriagonalGrid returns a grid originating at (0,0,0).
-	// there's a comment in the source about flipping the y-axis to
-	// change the direction of the triangle, but I don't understand
-	// how to implement that.
+	// It is the TriangleUp shape, which points left on flat-top layouts;
+	// see Triangle for both directions.
 	TriagonalGrid(side_length int) GridStore
 }
 
-// BottomRightHex returns (0,0,0) if the list of hexes is empty
+// HexBounds is the extent of a collection of hexes in offset, cube, and pixel space.
+type HexBounds struct {
+	// MinOffset and MaxOffset are the smallest and largest offset columns and rows.
+	// They are computed independently, so they may not be the coordinates of any hex in the collection.
+	MinOffset, MaxOffset OffsetCoord
+
+	// MinQ, MaxQ, MinR, MaxR, MinS, and MaxS are the extents of the cube coordinates.
+	MinQ, MaxQ int
+	MinR, MaxR int
+	MinS, MaxS int
+
+	// MinPixel and MaxPixel are the corners of the bounding box in screen coordinates.
+	// The box includes the corners of the hexes, not just the centers.
+	MinPixel, MaxPixel Point
+}
+
+// Bounds returns the extent of the hexes.
+// Returns ErrNoHexes if the list of hexes is empty.
+func Bounds(l Layout_i, hexes ...Hex) (HexBounds, error) {
+	if len(hexes) == 0 {
+		return HexBounds{}, ErrNoHexes
+	}
+
+	var b HexBounds
+	for n, h := range hexes {
+		oc := l.HexToOffsetCoord(h)
+		if n == 0 {
+			b.MinOffset, b.MaxOffset = oc, oc
+			b.MinQ, b.MaxQ, b.MinR, b.MaxR, b.MinS, b.MaxS = h.q, h.q, h.r, h.r, h.s, h.s
+			b.MinPixel, b.MaxPixel = l.HexToPixel(h), l.HexToPixel(h)
+		}
+		b.MinOffset.Col, b.MaxOffset.Col = min(b.MinOffset.Col, oc.Col), max(b.MaxOffset.Col, oc.Col)
+		b.MinOffset.Row, b.MaxOffset.Row = min(b.MinOffset.Row, oc.Row), max(b.MaxOffset.Row, oc.Row)
+		b.MinQ, b.MaxQ = min(b.MinQ, h.q), max(b.MaxQ, h.q)
+		b.MinR, b.MaxR = min(b.MinR, h.r), max(b.MaxR, h.r)
+		b.MinS, b.MaxS = min(b.MinS, h.s), max(b.MaxS, h.s)
+		for _, corner := range l.HexCorners(h) {
+			b.MinPixel.X, b.MaxPixel.X = min(b.MinPixel.X, corner.X), max(b.MaxPixel.X, corner.X)
+			b.MinPixel.Y, b.MaxPixel.Y = min(b.MinPixel.Y, corner.Y), max(b.MaxPixel.Y, corner.Y)
+		}
+	}
+
+	return b, nil
+}
+
+// Width returns the width of the pixel bounding box.
+func (b HexBounds) Width() float64 {
+	return b.MaxPixel.X - b.MinPixel.X
+}
+
+// Height returns the height of the pixel bounding box.
+func (b HexBounds) Height() float64 {
+	return b.MaxPixel.Y - b.MinPixel.Y
+}
+
+// BottomRightHex returns (0,0,0) if the list of hexes is empty.
+// Use Bounds if you need to know that the list was empty.
 func BottomRightHex(l Layout_i, hexes ...Hex) Hex {
 	var maxHex Hex
 	var maxOffset OffsetCoord
@@ -94,7 +164,8 @@ func BottomRightHex(l Layout_i, hexes ...Hex) Hex {
 	return maxHex
 }
 
-// TopLeftHex returns (0,0,0) if the list of hexes is empty
+// TopLeftHex returns (0,0,0) if the list of hexes is empty.
+// Use Bounds if you need to know that the list was empty.
 func TopLeftHex(l Layout_i, hexes ...Hex) Hex {
 	var minHex Hex
 	var minOffset OffsetCoord
@@ -112,3 +183,14 @@ func TopLeftHex(l Layout_i, hexes ...Hex) Hex {
 
 	return minHex
 }
+
+// offsetRect returns the hexes for every column and row in the rectangle.
+func offsetRect(toHex func(col, row int) Hex, colMin, colMax, rowMin, rowMax int) GridStore {
+	gs := GridStore{}
+	for col := colMin; col <= colMax; col++ {
+		for row := rowMin; row <= rowMax; row++ {
+			gs.Add(toHex(col, row))
+		}
+	}
+	return gs
+}
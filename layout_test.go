@@ -0,0 +1,154 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestBounds(t *testing.T) {
+	l := hexg.NewVerticalOddQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+
+	if _, err := hexg.Bounds(l); !errors.Is(err, hexg.ErrNoHexes) {
+		t.Errorf("bounds: empty: got %v, want %v\n", err, hexg.ErrNoHexes)
+	}
+
+	hexes := []hexg.Hex{
+		hexg.NewHex(1, 2, -3), // offset: (+1,+2)
+		hexg.NewHex(0, 0, 0),  // offset: (+0,+0)
+		hexg.NewHex(2, 0, -2), // offset: (+2,+1)
+		hexg.NewHex(-1, 1, 0), // offset: (-1,+0)
+		hexg.NewHex(1, -2, 1), // offset: (+1,-2)
+	}
+	b, err := hexg.Bounds(l, hexes...)
+	if err != nil {
+		t.Fatalf("bounds: error %v\n", err)
+	}
+	if got, want := b.MinOffset.ConciseString(), "-1-2"; got != want {
+		t.Errorf("bounds: min offset: got %q, want %q\n", got, want)
+	}
+	if got, want := b.MaxOffset.ConciseString(), "+2+2"; got != want {
+		t.Errorf("bounds: max offset: got %q, want %q\n", got, want)
+	}
+	for _, tc := range []struct {
+		name      string
+		got, want int
+	}{
+		{"min q", b.MinQ, -1}, {"max q", b.MaxQ, 2},
+		{"min r", b.MinR, -2}, {"max r", b.MaxR, 2},
+		{"min s", b.MinS, -3}, {"max s", b.MaxS, 1},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("bounds: %s: got %d, want %d\n", tc.name, tc.got, tc.want)
+		}
+	}
+
+	// flat-top hexes overhang the centers by the size horizontally and by sqrt(3)/2 vertically.
+	// the left-most hex is -1+1+0 and the right-most is +2+0-2.
+	// the top-most hex is +1-2+1 and the bottom-most is +1+2-3.
+	wantMin := hexg.NewPoint(-1.5-1, 0.5*math.Sqrt(3)*(1-4)-math.Sqrt(3)/2)
+	wantMax := hexg.NewPoint(3+1, 0.5*math.Sqrt(3)*(1+4)+math.Sqrt(3)/2)
+	if !closeTo(b.MinPixel, wantMin) || !closeTo(b.MaxPixel, wantMax) {
+		t.Errorf("bounds: pixels: got %v %v, want %v %v\n", b.MinPixel, b.MaxPixel, wantMin, wantMax)
+	}
+	if got, want := b.Width(), wantMax.X-wantMin.X; math.Abs(got-want) > 1e-9 {
+		t.Errorf("bounds: width: got %g, want %g\n", got, want)
+	}
+	if got, want := b.Height(), wantMax.Y-wantMin.Y; math.Abs(got-want) > 1e-9 {
+		t.Errorf("bounds: height: got %g, want %g\n", got, want)
+	}
+}
+
+func TestLayout_HexCorners(t *testing.T) {
+	// the corners must not depend on the origin being (0,0)
+	for _, l := range []hexg.Layout_i{
+		hexg.NewVerticalEvenQLayout(hexg.NewPoint(3, 2), hexg.NewPoint(10, -20)),
+		hexg.NewVerticalOddQLayout(hexg.NewPoint(3, 2), hexg.NewPoint(10, -20)),
+	} {
+		h := hexg.NewHex(2, -1, -1)
+		for i, corner := range l.HexCorners(h) {
+			if want := l.HexCorner(h, i); !closeTo(corner, want) {
+				t.Errorf("%s: corner %d: got %v, want %v\n", l.OffsetType(), i, corner, want)
+			}
+		}
+	}
+}
+
+func TestLayout_OffsetRect(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		layout hexg.Layout_i
+		expect string
+	}{
+		// even-q shoves the odd column up relative to odd-q
+		{id: 1, layout: hexg.NewVerticalEvenQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0)), expect: "+1-1+0 +0+0+0 +1+0-1 +0+1-1"},
+		{id: 2, layout: hexg.NewVerticalOddQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0)), expect: "+0+0+0 +1+0-1 +0+1-1 +1+1-2"},
+		{id: 3, layout: hexg.NewTribeNetLayout(), expect: "+0+0+0 +1+0-1 +0+1-1 +1+1-2"},
+	} {
+		if got := concise(tc.layout.OffsetRect(0, 1, 0, 1)); got != tc.expect {
+			t.Errorf("%d: %s: got %q, want %q\n", tc.id, tc.layout.OffsetType(), got, tc.expect)
+		}
+
+		// every hex in the grid is in the rectangle, and every column and row is in the grid
+		colMin, colMax, rowMin, rowMax := -3, 4, -2, 5
+		gs := tc.layout.OffsetRect(colMin, colMax, rowMin, rowMax)
+		if want := (colMax - colMin + 1) * (rowMax - rowMin + 1); len(gs) != want {
+			t.Errorf("%d: %s: len: got %d, want %d\n", tc.id, tc.layout.OffsetType(), len(gs), want)
+		}
+		for _, h := range gs {
+			if oc := tc.layout.HexToOffsetCoord(h); !(colMin <= oc.Col && oc.Col <= colMax && rowMin <= oc.Row && oc.Row <= rowMax) {
+				t.Errorf("%d: %s: %v: offset %v outside rectangle\n", tc.id, tc.layout.OffsetType(), h, oc)
+			}
+		}
+	}
+
+	// a TribeNet sheet is an offset rectangle of 30 columns by 21 rows
+	tn := hexg.NewTribeNetLayout()
+	sheet := tn.OffsetRect(30, 59, 21, 41)
+	for _, label := range []string{"BB 0101", "BB 3001", "BB 0121", "BB 3021"} {
+		if h, err := tn.TribeNetCoordToHex(label); err != nil {
+			t.Errorf("tn: %q: error %v\n", label, err)
+		} else if !sheet.Contains(h) {
+			t.Errorf("tn: %q: not in sheet\n", label)
+		}
+	}
+	for _, h := range sheet {
+		if label, err := tn.HexToTribeNetCoord(h); err != nil || label[:3] != "BB " {
+			t.Errorf("tn: %v: got %q %v, want sheet BB\n", h, label, err)
+		}
+	}
+}
+
+func TestLayout_OffsetRectAllOffsets(t *testing.T) {
+	size, origin := hexg.NewPoint(1, 1), hexg.NewPoint(0, 0)
+
+	for _, tc := range []struct {
+		id     int
+		layout hexg.Layout
+		expect string
+	}{
+		// even-q shoves even columns down and odd-q shoves odd columns down
+		{id: 1, layout: hexg.NewLayoutEvenQ(size, origin), expect: "+1-1+0 +0+0+0 +1+0-1 +0+1-1"},
+		{id: 2, layout: hexg.NewLayoutOddQ(size, origin), expect: "+0+0+0 +1+0-1 +0+1-1 +1+1-2"},
+		// even-r shoves even rows right and odd-r shoves odd rows right
+		{id: 3, layout: hexg.NewLayoutEvenR(size, origin), expect: "+0+0+0 +1+0-1 -1+1+0 +0+1-1"},
+		{id: 4, layout: hexg.NewLayoutOddR(size, origin), expect: "+0+0+0 +1+0-1 +0+1-1 +1+1-2"},
+	} {
+		if got := concise(tc.layout.OffsetRect(0, 1, 0, 1)); got != tc.expect {
+			t.Errorf("%d: got %q, want %q\n", tc.id, got, tc.expect)
+		}
+		if got, want := len(tc.layout.OffsetRect(-2, 3, -3, 2)), 6*6; got != want {
+			t.Errorf("%d: len: got %d, want %d\n", tc.id, got, want)
+		}
+	}
+
+	// the odd-q conversion lines up with the odd-q layout
+	l, oddQ := hexg.NewVerticalOddQLayout(size, origin), hexg.NewLayoutOddQ(size, origin)
+	if got, want := concise(oddQ.OffsetRect(-3, 3, -3, 3)), concise(l.OffsetRect(-3, 3, -3, 3)); got != want {
+		t.Errorf("odd-q: got %q, want %q\n", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+// Morphological operations treat a GridStore as a set of hexes and use a
+// hexagon of the given radius as the structuring element. A hex is within
+// the element when its Hex.Distance from the center is at most the radius.
+// Every operation returns a new set and leaves the receiver unchanged.
+
+// Dilate returns the hexes that are within the radius of a hex in the set.
+func (gs GridStore) Dilate(radius int) GridStore {
+	dilated := make(GridStore, len(gs))
+	frontier := make([]Hex, 0, len(gs))
+	for key, h := range gs {
+		dilated[key] = h
+		frontier = append(frontier, h)
+	}
+	// grow the set by one ring of neighbors at a time
+	for step := 0; step < radius; step++ {
+		var next []Hex
+		for _, h := range frontier {
+			for direction := 0; direction < 6; direction++ {
+				if n := h.Neighbor(direction); !dilated.Contains(n) {
+					dilated.Add(n)
+					next = append(next, n)
+				}
+			}
+		}
+		frontier = next
+	}
+	return dilated
+}
+
+// Erode returns the hexes in the set whose every hex within the radius is also in the set.
+func (gs GridStore) Erode(radius int) GridStore {
+	eroded := GridStore{}
+	for key, cell := range gs.DistanceTransform() {
+		if cell.Value > radius {
+			eroded[key] = cell.Hex
+		}
+	}
+	return eroded
+}
+
+// Open returns the set eroded and then dilated.
+// It removes spurs, bridges and islands that are narrower than the element.
+func (gs GridStore) Open(radius int) GridStore {
+	return gs.Erode(radius).Dilate(radius)
+}
+
+// Close returns the set dilated and then eroded.
+// It fills gaps, bays and holes that are narrower than the element.
+func (gs GridStore) Close(radius int) GridStore {
+	return gs.Dilate(radius).Erode(radius)
+}
+
+// Boundary returns the hexes in the set that have a neighbor outside of the set.
+// It is the inner ring of the set.
+func (gs GridStore) Boundary() GridStore {
+	boundary := GridStore{}
+	for key, h := range gs {
+		for direction := 0; direction < 6; direction++ {
+			if !gs.Contains(h.Neighbor(direction)) {
+				boundary[key] = h
+				break
+			}
+		}
+	}
+	return boundary
+}
+
+// OuterBoundary returns the hexes outside of the set that have a neighbor in the set.
+// It is the ring around the set, including the rings around any holes.
+func (gs GridStore) OuterBoundary() GridStore {
+	return gs.Dilate(1).Subtract(gs)
+}
+
+// Subtract returns the hexes in the set that are not in the other set.
+func (gs GridStore) Subtract(other GridStore) GridStore {
+	difference := GridStore{}
+	for key, h := range gs {
+		if !other.Contains(h) {
+			difference[key] = h
+		}
+	}
+	return difference
+}
+
+// DistanceTransform returns, for every hex in the set, the Hex.Distance to
+// the nearest hex that is not in the set. Hexes on the boundary are 1.
+//
+// The distances come from a breadth-first search inward from the boundary.
+// A shortest line from a hex to the nearest hex outside of the set never
+// leaves the set before its last step, so the search distance is the same
+// as Hex.Distance.
+func (gs GridStore) DistanceTransform() Grid[int] {
+	distances := Grid[int]{}
+	var queue []Hex
+	for _, h := range gs.Boundary().Hexes() {
+		distances.Set(h, 1)
+		queue = append(queue, h)
+	}
+	for len(queue) > 0 {
+		h := queue[0]
+		queue = queue[1:]
+		d, _ := distances.Get(h)
+		for direction := 0; direction < 6; direction++ {
+			n := h.Neighbor(direction)
+			if gs.Contains(n) && !distances.Contains(n) {
+				distances.Set(n, d+1)
+				queue = append(queue, n)
+			}
+		}
+	}
+	return distances
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestGridStore_Morphology(t *testing.T) {
+	hexagon := func(radius int) hexg.GridStore {
+		return hexg.HexagonalGrid(radius)
+	}
+	// an island far away from the hexagon
+	withIsland := hexagon(2)
+	withIsland.Add(hexg.NewHex(5, 0, -5))
+	// a hole in the middle of the hexagon
+	withHole := hexagon(3)
+	delete(withHole, hexg.NewHex(0, 0, 0).Hash())
+	// the ring around the hexagon and the hole itself
+	aroundHole := hexagon(4).Subtract(hexagon(3))
+	aroundHole.Add(hexg.NewHex(0, 0, 0))
+
+	for _, tc := range []struct {
+		id   int
+		got  hexg.GridStore
+		want hexg.GridStore
+	}{
+		{1, hexagon(2).Dilate(0), hexagon(2)},
+		{2, hexagon(2).Dilate(1), hexagon(3)},
+		{3, hexagon(1).Dilate(3), hexagon(4)},
+		{4, hexagon(3).Erode(1), hexagon(2)},
+		{5, hexagon(3).Erode(3), hexagon(0)},
+		{6, hexagon(3).Erode(4), hexg.GridStore{}},
+		{7, withIsland.Open(1), hexagon(2)},
+		{8, withHole.Close(1), hexagon(3)},
+		{9, hexagon(2).Boundary(), hexagon(2).Subtract(hexagon(1))},
+		{10, hexagon(2).OuterBoundary(), hexagon(3).Subtract(hexagon(2))},
+		{11, withHole.OuterBoundary(), aroundHole},
+	} {
+		if got, want := concise(tc.got), concise(tc.want); got != want {
+			t.Errorf("%d: got %s\n\twant %s\n", tc.id, got, want)
+		}
+	}
+}
+
+func TestGridStore_MorphologyDistance(t *testing.T) {
+	// an irregular land mask from generated terrain
+	l := hexg.NewTribeNetLayout()
+	land := hexg.GridStore{}
+	for _, cell := range hexg.GenerateTerrain(l, l.OffsetRect(0, 15, 0, 12), hexg.TerrainParams{Seed: 3}).Cells() {
+		if cell.Value.Elevation > 0.45 {
+			land.Add(cell.Hex)
+		}
+	}
+	if len(land) < 20 {
+		t.Fatalf("land: got %d hexes, want at least 20\n", len(land))
+	}
+	universe := hexg.HexagonalGrid(25)
+	// nearest returns the distance from h to the nearest hex in (or not in) the set.
+	nearest := func(h hexg.Hex, in bool) int {
+		best := -1
+		for _, x := range universe {
+			if land.Contains(x) == in && (best < 0 || h.Distance(x) < best) {
+				best = h.Distance(x)
+			}
+		}
+		return best
+	}
+
+	distances := land.DistanceTransform()
+	if len(distances) != len(land) {
+		t.Errorf("distance transform: len: got %d, want %d\n", len(distances), len(land))
+	}
+	for _, h := range land.Hexes() {
+		if got, want := distances[h.Hash()].Value, nearest(h, false); got != want {
+			t.Errorf("distance transform: %v: got %d, want %d\n", h, got, want)
+		}
+	}
+
+	for radius := 0; radius <= 2; radius++ {
+		dilated, eroded := land.Dilate(radius), land.Erode(radius)
+		for _, h := range universe.Hexes() {
+			if got, want := dilated.Contains(h), nearest(h, true) <= radius; got != want {
+				t.Errorf("dilate %d: %v: got %v, want %v\n", radius, h, got, want)
+			}
+			if got, want := eroded.Contains(h), land.Contains(h) && nearest(h, false) > radius; got != want {
+				t.Errorf("erode %d: %v: got %v, want %v\n", radius, h, got, want)
+			}
+		}
+	}
+}
@@ -87,6 +87,13 @@ func (l VerticalOddQLayout) OffsetCoordToHex(oc OffsetCoord) Hex {
 	return l.OffsetColRowToHex(oc.Col, oc.Row)
 }
 
+// OffsetRect returns the hexes whose offset coordinates are in the rectangle.
+// The bounds are inclusive and follow the stagger of the layout,
+// so the grid lines up with HexToOffsetCoord.
+func (l VerticalOddQLayout) OffsetRect(colMin, colMax, rowMin, rowMax int) GridStore {
+	return offsetRect(l.OffsetColRowToHex, colMin, colMax, rowMin, rowMax)
+}
+
 func (l VerticalOddQLayout) OffsetType() LayoutOffset_e {
 	return OddQ
 }
@@ -102,6 +109,12 @@ func (l VerticalOddQLayout) ParallelogramGrid(q1, r1, q2, r2 int) GridStore {
 	return gs
 }
 
+// PixelToEdge returns the edge nearest to the pixel and the distance to it in pixels.
+// Ok is false when the distance is greater than the tolerance.
+func (l VerticalOddQLayout) PixelToEdge(p Point, tolerance float64) (Edge, float64, bool) {
+	return pixelToEdge(l, p, tolerance)
+}
+
 func (l VerticalOddQLayout) PixelToFractionalHex(p Point) FractionalHex {
 	M := verticalOrientation
 	pt := Point{X: (p.X - l.origin.X) / l.size.X, Y: (p.Y - l.origin.Y) / l.size.Y}
@@ -114,6 +127,12 @@ func (l VerticalOddQLayout) PixelToHexRounded(p Point) Hex {
 	return l.PixelToFractionalHex(p).Round()
 }
 
+// PixelToVertex returns the vertex nearest to the pixel and the distance to it in pixels.
+// Ok is false when the distance is greater than the tolerance.
+func (l VerticalOddQLayout) PixelToVertex(p Point, tolerance float64) (Vertex, float64, bool) {
+	return pixelToVertex(l, p, tolerance)
+}
+
 func (l VerticalOddQLayout) PolygonCornerOffset(corner int) Point {
 	M := verticalOrientation
 	size := l.size
@@ -122,16 +141,20 @@ func (l VerticalOddQLayout) PolygonCornerOffset(corner int) Point {
 	return Point{X: size.X * math.Cos(angle), Y: size.Y * math.Sin(angle)}
 }
 
+// PolygonCornerOffsets returns the offset from the center of a hex to every corner.
+// The offsets don't include the origin of the layout; HexCorners adds the center of the hex.
 func (l VerticalOddQLayout) PolygonCornerOffsets() [6]Point {
 	var corners [6]Point
-	center := l.HexToPixel(Hex{})
 	for i := 0; i < 6; i++ {
-		offset := l.PolygonCornerOffset(i)
-		corners[i] = Point{X: center.X + offset.X, Y: center.Y + offset.Y}
+		corners[i] = l.PolygonCornerOffset(i)
 	}
 	return corners
 }
 
+// RectangularGrid returns a grid centered about a hex.
+// The stagger is always q>>1, which is the odd-q stagger, and it is relative to
+// the center rather than to column 0. Use OffsetRect for a grid that lines up
+// with the offset coordinates of the layout.
 func (l VerticalOddQLayout) RectangularGrid(center Hex, left, right, top, bottom int) GridStore {
 	gs := GridStore{}
 	for q := left; q <= right; q++ {
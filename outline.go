@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+// Ring is a closed boundary around part of a region.
+type Ring struct {
+	// Edges are the hex edges on the boundary, in order around the ring.
+	Edges []Edge
+
+	// Points are the screen coordinates of the corners of the ring.
+	// Points[i] is the start of Edges[i]; the ring closes from the last point back to the first.
+	Points []Point
+}
+
+// Outline is the boundary of one connected region of hexes.
+type Outline struct {
+	// Outer is the ring around the outside of the region.
+	Outer Ring
+
+	// Holes are the rings around the holes in the region, if any.
+	// A hole is a set of hexes that are not in the region but are
+	// completely surrounded by it.
+	Holes []Ring
+}
+
+// Outlines returns the boundary of the hexes as polygons.
+//
+// There is one Outline for every connected region of hexes. Outer rings go
+// around the region in the same rotation as the directions 0, 1, 2, and so on
+// (counter-clockwise on a screen where y points down) and hole rings go the
+// other way.
+//
+// The results are ordered by the first hex (sorted by r and then q) in each
+// region, so the same hexes always produce the same outlines.
+func Outlines(l Layout_i, gs GridStore) []Outline {
+	// label the connected regions so that the rings can be grouped by region.
+	hexes := gs.Hexes()
+	components := ConnectedComponents(gs, FillOptions{})
+
+	// the sign of the area of a single hex, walked in direction order, tells
+	// us which way outer rings wind. it depends on the layout's size, since a
+	// negative size flips an axis.
+	var unit []Point
+	for k := 0; k < 6; k++ {
+		unit = append(unit, VertexToPixel(l, NewVertex(Hex{}, k)))
+	}
+	outerSign := signedArea(unit) > 0
+
+	outlines := make([]Outline, len(components.Sizes))
+
+	// a half-edge is the side of a hex in the region that faces a hex outside the region.
+	type halfEdge struct {
+		hex       Hex
+		direction int
+	}
+	visited := map[halfEdge]bool{}
+	for _, h := range hexes {
+		for direction := 0; direction < 6; direction++ {
+			start := halfEdge{hex: h, direction: direction}
+			if visited[start] || gs.Contains(h.Neighbor(direction)) {
+				continue
+			}
+			var ring Ring
+			for he := start; !visited[he]; {
+				visited[he] = true
+				ring.Edges = append(ring.Edges, NewEdge(he.hex, he.direction))
+				ring.Points = append(ring.Points, VertexToPixel(l, NewVertex(he.hex, he.direction-1)))
+				// walk to the corner between this direction and the next one.
+				// if the hex on the other side of that corner is outside the region,
+				// the boundary turns to the next side of this hex. otherwise it
+				// continues along the side of that hex which faces the same outside hex.
+				next := he.hex.Neighbor(he.direction + 1)
+				if !gs.Contains(next) {
+					he = halfEdge{hex: he.hex, direction: (he.direction + 1) % 6}
+				} else {
+					he = halfEdge{hex: next, direction: (he.direction + 5) % 6}
+				}
+			}
+			n, _ := components.Labels.Get(h)
+			if (signedArea(ring.Points) > 0) == outerSign {
+				outlines[n].Outer = ring
+			} else {
+				outlines[n].Holes = append(outlines[n].Holes, ring)
+			}
+		}
+	}
+
+	return outlines
+}
+
+// signedArea returns the signed area of a polygon using the shoelace formula.
+func signedArea(points []Point) float64 {
+	var area float64
+	for i := range points {
+		a, b := points[i], points[(i+1)%len(points)]
+		area += a.X*b.Y - b.X*a.Y
+	}
+	return area / 2
+}
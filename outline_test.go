@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestOutlines(t *testing.T) {
+	l := hexg.NewVerticalOddQLayout(hexg.NewPoint(10, 10), hexg.NewPoint(3, 4))
+
+	// a donut is a hexagon with the center removed
+	donut := l.HexagonalGrid(hexg.NewHex(0, 0, 0), 2)
+	delete(donut, hexg.NewHex(0, 0, 0).Hash())
+
+	// two islands with a gap between them
+	islands := hexg.GridStore{}
+	for _, h := range []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(5, -2, -3)} {
+		islands.Add(h)
+	}
+
+	for _, tc := range []struct {
+		id    string
+		gs    hexg.GridStore
+		outer []int // number of edges in each outer ring
+		holes []int // number of holes in each region
+	}{
+		{id: "single", gs: hexg.GridStore{hexg.NewHex(2, -1, -1).Hash(): hexg.NewHex(2, -1, -1)}, outer: []int{6}, holes: []int{0}},
+		{id: "hexagon", gs: l.HexagonalGrid(hexg.NewHex(1, 1, -2), 2), outer: []int{30}, holes: []int{0}},
+		{id: "donut", gs: donut, outer: []int{30}, holes: []int{1}},
+		{id: "islands", gs: islands, outer: []int{6, 10}, holes: []int{0, 0}},
+	} {
+		outlines := hexg.Outlines(l, tc.gs)
+		if len(outlines) != len(tc.outer) {
+			t.Errorf("%s: outlines: got %d, want %d\n", tc.id, len(outlines), len(tc.outer))
+			continue
+		}
+		var area float64
+		edges := map[hexg.Edge]int{}
+		for i, o := range outlines {
+			if len(o.Outer.Edges) != tc.outer[i] {
+				t.Errorf("%s: %d: outer: got %d edges, want %d\n", tc.id, i, len(o.Outer.Edges), tc.outer[i])
+			}
+			if len(o.Holes) != tc.holes[i] {
+				t.Errorf("%s: %d: holes: got %d, want %d\n", tc.id, i, len(o.Holes), tc.holes[i])
+			}
+			area += math.Abs(polygonArea(o.Outer.Points))
+			for _, hole := range o.Holes {
+				area -= math.Abs(polygonArea(hole.Points))
+			}
+			for _, ring := range append([]hexg.Ring{o.Outer}, o.Holes...) {
+				if len(ring.Points) != len(ring.Edges) {
+					t.Errorf("%s: %d: ring: got %d points for %d edges\n", tc.id, i, len(ring.Points), len(ring.Edges))
+				}
+				for j, e := range ring.Edges {
+					edges[e]++
+					// consecutive edges must share a corner
+					ends := hexg.EdgeToPixels(l, e)
+					next := ring.Points[(j+1)%len(ring.Points)]
+					if !closeTo(ends[0], next) && !closeTo(ends[1], next) {
+						t.Errorf("%s: %d: ring: edge %v does not end at %v\n", tc.id, i, e, next)
+					}
+				}
+			}
+		}
+
+		// every side of a hex that faces outside the set is on exactly one ring
+		var sides int
+		for _, h := range tc.gs {
+			for direction := 0; direction < 6; direction++ {
+				if !tc.gs.Contains(h.Neighbor(direction)) {
+					sides++
+					if edges[hexg.NewEdge(h, direction)] != 1 {
+						t.Errorf("%s: edge %v: got %d rings, want 1\n", tc.id, hexg.NewEdge(h, direction), edges[hexg.NewEdge(h, direction)])
+					}
+				}
+			}
+		}
+		if sides != len(edges) {
+			t.Errorf("%s: edges: got %d, want %d\n", tc.id, len(edges), sides)
+		}
+
+		if want := float64(len(tc.gs)) * hexg.HexArea(l); math.Abs(area-want) > 1e-6 {
+			t.Errorf("%s: area: got %g, want %g\n", tc.id, area, want)
+		}
+	}
+}
+
+// polygonArea returns the signed area of the polygon.
+func polygonArea(points []hexg.Point) float64 {
+	var area float64
+	for i := range points {
+		a, b := points[i], points[(i+1)%len(points)]
+		area += a.X*b.Y - b.X*a.Y
+	}
+	return area / 2
+}
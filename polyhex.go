@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"iter"
+	"slices"
+)
+
+// Polyhex_e identifies which polyhexes are counted as the same.
+type Polyhex_e int
+
+const (
+	// PolyhexFixed polyhexes are the same only if one can be moved onto the other.
+	PolyhexFixed Polyhex_e = iota
+	// PolyhexOneSided polyhexes are also the same if one can be rotated onto the other.
+	PolyhexOneSided
+	// PolyhexFree polyhexes are also the same if one can be reflected onto the other.
+	PolyhexFree
+)
+
+// String implements the Stringer interface.
+func (k Polyhex_e) String() string {
+	switch k {
+	case PolyhexFixed:
+		return "fixed"
+	case PolyhexOneSided:
+		return "one-sided"
+	case PolyhexFree:
+		return "free"
+	}
+	return "?"
+}
+
+// transforms returns the symmetries that don't make a new polyhex of the kind.
+func (k Polyhex_e) transforms() []Transform {
+	switch k {
+	case PolyhexOneSided:
+		return Transforms()[:6]
+	case PolyhexFree:
+		return Transforms()
+	}
+	return Transforms()[:1]
+}
+
+// Polyhexes returns an iterator over the polyhexes of n hexes.
+// Each polyhex is yielded once, as its canonical form for the kind: the
+// hexes are sorted by r and then by q and the first one is the origin.
+// The iterator yields a new slice every time, so the caller may keep it.
+//
+// Polyhexes grow quickly with n, so they are generated as they are needed
+// with Redelmeier's algorithm rather than collected first. The order is the
+// same from one run to the next.
+func Polyhexes(n int, kind Polyhex_e) iter.Seq[[]Hex] {
+	return func(yield func([]Hex) bool) {
+		if n < 1 {
+			return
+		}
+		transforms := kind.transforms()
+
+		// Redelmeier's algorithm grows every fixed polyhex that has the origin
+		// as its first hex exactly once. It only adds hexes that sort after
+		// the origin, and it never tries a hex in a branch where it has
+		// already been tried and rejected.
+		origin := Hex{}
+		allowed := func(h Hex) bool {
+			return h.r > 0 || (h.r == 0 && h.q >= 0)
+		}
+		polyhex := make([]Hex, 0, n)
+		seen := map[Hex]bool{origin: true}
+
+		var grow func(untried []Hex) bool
+		grow = func(untried []Hex) bool {
+			for len(untried) > 0 {
+				h := untried[len(untried)-1]
+				untried = untried[:len(untried)-1]
+				polyhex = append(polyhex, h)
+				if len(polyhex) == n {
+					// yield the fixed polyhex only if it is the canonical
+					// form of all the polyhexes that are the same as it
+					shape := NormalizeShape(polyhex)
+					if canonical, _ := canonicalShape(shape, transforms); slices.Equal(shape, canonical) {
+						if !yield(shape) {
+							return false
+						}
+					}
+				} else {
+					next := slices.Clone(untried)
+					var added []Hex
+					for direction := 0; direction < 6; direction++ {
+						if nb := h.Neighbor(direction); allowed(nb) && !seen[nb] {
+							seen[nb] = true
+							added = append(added, nb)
+							next = append(next, nb)
+						}
+					}
+					ok := grow(next)
+					for _, nb := range added {
+						delete(seen, nb)
+					}
+					if !ok {
+						return false
+					}
+				}
+				polyhex = polyhex[:len(polyhex)-1]
+			}
+			return true
+		}
+		grow([]Hex{origin})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestPolyhexes(t *testing.T) {
+	// the counts are OEIS A001207 (fixed), A006535 (one-sided) and A000228 (free),
+	// starting with n = 1.
+	for _, tc := range []struct {
+		kind hexg.Polyhex_e
+		want []int
+	}{
+		{hexg.PolyhexFixed, []int{1, 3, 11, 44, 186, 814}},
+		{hexg.PolyhexOneSided, []int{1, 1, 3, 10, 33, 147}},
+		{hexg.PolyhexFree, []int{1, 1, 3, 7, 22, 82}},
+	} {
+		for n, want := range tc.want {
+			n++
+			seen := map[string]bool{}
+			count := 0
+			for polyhex := range hexg.Polyhexes(n, tc.kind) {
+				count++
+				if len(polyhex) != n {
+					t.Errorf("%s: %d: got %d hexes, want %d\n", tc.kind, n, len(polyhex), n)
+				}
+				if got := hexg.NormalizeShape(polyhex); !slices.Equal(got, polyhex) {
+					t.Errorf("%s: %d: got %v, want the normal form %v\n", tc.kind, n, polyhex, got)
+				}
+				if components := hexg.ConnectedComponents(toGridStore(polyhex), hexg.FillOptions{}); len(components.Sizes) != 1 {
+					t.Errorf("%s: %d: %v: got %d components, want 1\n", tc.kind, n, polyhex, len(components.Sizes))
+				}
+				key := concise(toGridStore(polyhex))
+				if seen[key] {
+					t.Errorf("%s: %d: %v: duplicate\n", tc.kind, n, polyhex)
+				}
+				seen[key] = true
+			}
+			if count != want {
+				t.Errorf("%s: %d: got %d, want %d\n", tc.kind, n, count, want)
+			}
+		}
+	}
+
+	// free polyhexes all have different shape hashes
+	hashes := map[uint64]bool{}
+	for polyhex := range hexg.Polyhexes(5, hexg.PolyhexFree) {
+		hashes[hexg.ShapeHash(polyhex)] = true
+	}
+	if len(hashes) != 22 {
+		t.Errorf("free: 5: hashes: got %d, want 22\n", len(hashes))
+	}
+
+	// the iterator stops when asked
+	count := 0
+	for range hexg.Polyhexes(6, hexg.PolyhexFixed) {
+		if count++; count == 10 {
+			break
+		}
+	}
+	if count != 10 {
+		t.Errorf("break: got %d, want 10\n", count)
+	}
+
+	for range hexg.Polyhexes(0, hexg.PolyhexFree) {
+		t.Errorf("0: got a polyhex, want none\n")
+	}
+}
+
+// toGridStore returns a grid store with the hexes.
+func toGridStore(hexes []hexg.Hex) hexg.GridStore {
+	gs := hexg.GridStore{}
+	for _, h := range hexes {
+		gs.Add(h)
+	}
+	return gs
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"container/heap"
+)
+
+// queueItem is a hex waiting in a priority queue.
+type queueItem struct {
+	hex    Hex
+	cost   float64
+	source int // index of the source that reached the hex
+}
+
+// hexQueue is a priority queue of hexes for Dijkstra searches.
+// Items are ordered by cost, then by source, then by hex, so that
+// searches are deterministic when costs tie.
+// It implements heap.Interface; use push and pop instead of the heap methods.
+type hexQueue []queueItem
+
+func (pq hexQueue) Len() int { return len(pq) }
+
+func (pq hexQueue) Less(i, j int) bool {
+	if pq[i].cost != pq[j].cost {
+		return pq[i].cost < pq[j].cost
+	} else if pq[i].source != pq[j].source {
+		return pq[i].source < pq[j].source
+	}
+	return compareHexes(pq[i].hex, pq[j].hex) < 0
+}
+
+func (pq hexQueue) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+
+func (pq *hexQueue) Push(x any) { *pq = append(*pq, x.(queueItem)) }
+
+func (pq *hexQueue) Pop() any {
+	old := *pq
+	item := old[len(old)-1]
+	*pq = old[:len(old)-1]
+	return item
+}
+
+// push adds the item to the queue.
+func (pq *hexQueue) push(item queueItem) {
+	heap.Push(pq, item)
+}
+
+// pop removes and returns the item with the lowest cost.
+func (pq *hexQueue) pop() queueItem {
+	return heap.Pop(pq).(queueItem)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Reducers combine a list of values into one value.
+// They are used with Aggregate and Filter, for example to find the majority
+// terrain or the total population of a superhex. Every reducer returns the
+// zero value for an empty list.
+
+// Number is the set of types that the numeric reducers accept.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Majority returns the most common value.
+// Ties go to the value that appears first.
+func Majority[T comparable](values []T) T {
+	var best T
+	counts, bestCount := map[T]int{}, 0
+	for _, v := range values {
+		counts[v]++
+	}
+	for _, v := range values {
+		if counts[v] > bestCount {
+			best, bestCount = v, counts[v]
+		}
+	}
+	return best
+}
+
+// Sum returns the sum of the values.
+func Sum[T Number](values []T) T {
+	var sum T
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+// Mean returns the mean of the values.
+func Mean[T Number](values []T) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += float64(v)
+	}
+	return sum / float64(len(values))
+}
+
+// Min returns the smallest value.
+func Min[T cmp.Ordered](values []T) T {
+	if len(values) == 0 {
+		var zero T
+		return zero
+	}
+	return slices.Min(values)
+}
+
+// Max returns the largest value.
+func Max[T cmp.Ordered](values []T) T {
+	if len(values) == 0 {
+		var zero T
+		return zero
+	}
+	return slices.Max(values)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestReducers(t *testing.T) {
+	values := []int{4, 1, 3, 1, 4}
+	if got := hexg.Majority(values); got != 4 {
+		t.Errorf("majority: got %d, want %d\n", got, 4)
+	}
+	if got := hexg.Majority([]string{"hills", "forest", "forest"}); got != "forest" {
+		t.Errorf("majority: got %q, want %q\n", got, "forest")
+	}
+	if got := hexg.Sum(values); got != 13 {
+		t.Errorf("sum: got %d, want %d\n", got, 13)
+	}
+	if got := hexg.Mean(values); got != 2.6 {
+		t.Errorf("mean: got %g, want %g\n", got, 2.6)
+	}
+	if got := hexg.Min(values); got != 1 {
+		t.Errorf("min: got %d, want %d\n", got, 1)
+	}
+	if got := hexg.Max(values); got != 4 {
+		t.Errorf("max: got %d, want %d\n", got, 4)
+	}
+
+	// empty lists give zero values
+	if hexg.Majority([]string{}) != "" || hexg.Sum([]float64{}) != 0 || hexg.Mean([]int{}) != 0 || hexg.Min([]int{}) != 0 || hexg.Max([]int{}) != 0 {
+		t.Errorf("empty: got non-zero values\n")
+	}
+}
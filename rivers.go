@@ -0,0 +1,211 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Rivers run along the edges of hexes, from vertex to vertex, rather than
+// through the centers of hexes. That way a river separates the hexes on
+// either side of it, which is what the pathfinder and flood fill need.
+
+// RiverParams are the parameters for GenerateRivers.
+type RiverParams struct {
+	// Sources is the number of rivers to start.
+	// Rivers start at the highest vertices that are not already on a river.
+	Sources int
+
+	// SeaLevel is the elevation of the sea. Hexes at or below it are sea,
+	// and a river ends when it reaches a vertex that touches the sea.
+	SeaLevel float64
+}
+
+// RiverLayer is a set of rivers on the edges of a grid.
+type RiverLayer struct {
+	// Flow is the number of sources upstream of each edge with a river.
+	// It goes up where tributaries join.
+	Flow map[Edge]int
+
+	// Lakes are the hexes that rivers drained into because there was
+	// no lower vertex to flow to.
+	Lakes GridStore
+}
+
+// GenerateRivers returns rivers that flow downhill over the elevation grid.
+//
+// The elevation of a vertex is the mean elevation of the hexes around it
+// that are in the grid. Each river starts at a source and moves to the
+// lowest neighboring vertex until it
+//   - reaches the sea,
+//   - reaches the edge of the grid,
+//   - joins another river, whose flow then increases all the way downstream, or
+//   - reaches a basin with no lower neighbor, where the lowest hex becomes a lake.
+//
+// The same grid and parameters always give the same rivers.
+func GenerateRivers(elevation Grid[float64], params RiverParams) RiverLayer {
+	rl := RiverLayer{Flow: map[Edge]int{}, Lakes: GridStore{}}
+
+	// vertexElevation returns the mean elevation of the vertex.
+	// Ok is false if none of the hexes around the vertex are in the grid.
+	vertexElevation := func(v Vertex) (float64, bool) {
+		var sum float64
+		var n int
+		for _, h := range v.Hexes() {
+			if e, ok := elevation.Get(h); ok {
+				sum, n = sum+e, n+1
+			}
+		}
+		return sum / float64(max(n, 1)), n > 0
+	}
+	// interior returns true if all the hexes around the vertex are in the grid.
+	interior := func(v Vertex) bool {
+		for _, h := range v.Hexes() {
+			if !elevation.Contains(h) {
+				return false
+			}
+		}
+		return true
+	}
+	// touchesSea returns true if any hex around the vertex is sea.
+	touchesSea := func(v Vertex) bool {
+		for _, h := range v.Hexes() {
+			if e, ok := elevation.Get(h); ok && e <= params.SeaLevel {
+				return true
+			}
+		}
+		return false
+	}
+
+	// candidates for sources are the interior vertices above the sea,
+	// highest first and then in vertex order for ties.
+	type vertexHeight struct {
+		vertex Vertex
+		height float64
+	}
+	var candidates []vertexHeight
+	for _, h := range elevation.Hexes() {
+		for corner := 0; corner < 2; corner++ {
+			v := Vertex{hex: h, corner: corner}
+			if !interior(v) || touchesSea(v) {
+				continue
+			}
+			height, _ := vertexElevation(v)
+			candidates = append(candidates, vertexHeight{vertex: v, height: height})
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b vertexHeight) int {
+		return cmp.Compare(b.height, a.height)
+	})
+
+	// downstream is the next vertex for every vertex that is on a river.
+	// river mouths and lakes are on a river but have no next vertex.
+	downstream := map[Vertex]*Vertex{}
+	sources := 0
+	for _, candidate := range candidates {
+		if sources >= params.Sources {
+			break
+		} else if _, ok := downstream[candidate.vertex]; ok {
+			continue
+		}
+		sources++
+
+		v := candidate.vertex
+		for {
+			if next, ok := downstream[v]; ok {
+				// this vertex is already on a river, so join it
+				if next == nil {
+					break
+				}
+				rl.Flow[vertexEdge(v, *next)]++
+				v = *next
+				continue
+			}
+			if touchesSea(v) || !interior(v) {
+				downstream[v] = nil
+				break
+			}
+			height, _ := vertexElevation(v)
+			var lowest *Vertex
+			lowestHeight := height
+			for _, n := range v.Neighbors() {
+				if nh, ok := vertexElevation(n); ok && nh < lowestHeight {
+					lowest, lowestHeight = &n, nh
+				}
+			}
+			downstream[v] = lowest
+			if lowest == nil {
+				// a basin; the lowest hex around it fills with water
+				var lake Hex
+				lakeHeight := 0.0
+				for i, h := range v.Hexes() {
+					if e, _ := elevation.Get(h); i == 0 || e < lakeHeight {
+						lake, lakeHeight = h, e
+					}
+				}
+				rl.Lakes.Add(lake)
+				break
+			}
+			rl.Flow[vertexEdge(v, *lowest)]++
+			v = *lowest
+		}
+	}
+
+	return rl
+}
+
+// vertexEdge returns the edge between two neighboring vertices.
+func vertexEdge(a, b Vertex) Edge {
+	for i, n := range a.Neighbors() {
+		if n == b {
+			return a.Edges()[i]
+		}
+	}
+	panic("assert(vertices are neighbors)")
+}
+
+// Blocks returns true if there is a river on the edge.
+// It can be used as FillOptions.Blocked so that rivers split land.
+func (rl RiverLayer) Blocks(e Edge) bool {
+	return rl.Flow[e] > 0
+}
+
+// Edges returns the edges with rivers, sorted by hex and then by direction.
+func (rl RiverLayer) Edges() []Edge {
+	edges := make([]Edge, 0, len(rl.Flow))
+	for e := range rl.Flow {
+		edges = append(edges, e)
+	}
+	slices.SortFunc(edges, func(a, b Edge) int {
+		if c := compareHexes(a.hex, b.hex); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.direction, b.direction)
+	})
+	return edges
+}
+
+// CrossingCost returns a cost function that adds the penalty to the cost
+// of moving across an edge with a river. A negative penalty makes rivers
+// impassable. If cost is nil, the base cost of every move is 1.
+func (rl RiverLayer) CrossingCost(cost CostFunc, penalty float64) CostFunc {
+	return func(from, to Hex) float64 {
+		base := 1.0
+		if cost != nil {
+			base = cost(from, to)
+		}
+		for direction := 0; direction < 6; direction++ {
+			if from.Neighbor(direction) == to {
+				if rl.Blocks(NewEdge(from, direction)) {
+					if penalty < 0 {
+						return -1
+					}
+					return base + penalty
+				}
+				break
+			}
+		}
+		return base
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestGenerateRivers_Slope(t *testing.T) {
+	// land slopes down to the east, with the sea in the last columns
+	l := hexg.NewVerticalEvenQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	gs := l.OffsetRect(0, 9, 0, 5)
+	elevation := hexg.NewGrid(gs, func(h hexg.Hex) float64 {
+		return float64(10 - l.HexToOffsetCoord(h).Col)
+	})
+
+	rl := hexg.GenerateRivers(elevation, hexg.RiverParams{Sources: 1, SeaLevel: 2})
+	if len(rl.Flow) == 0 {
+		t.Fatalf("slope: no river\n")
+	}
+	if len(rl.Lakes) != 0 {
+		t.Errorf("slope: lakes: got %d, want %d\n", len(rl.Lakes), 0)
+	}
+	for _, e := range rl.Edges() {
+		if rl.Flow[e] != 1 {
+			t.Errorf("slope: %v: flow: got %d, want %d\n", e, rl.Flow[e], 1)
+		}
+		if !rl.Blocks(e) {
+			t.Errorf("slope: %v: not blocked\n", e)
+		}
+	}
+
+	// the river runs from the high west to the sea in the east.
+	// it leaves the source between the first two columns and stops at the
+	// first vertex that touches column 8, which is at sea level.
+	var minCol, maxCol int
+	for n, e := range rl.Edges() {
+		for _, h := range e.Hexes() {
+			col := l.HexToOffsetCoord(h).Col
+			if n == 0 || col < minCol {
+				minCol = col
+			}
+			if n == 0 || col > maxCol {
+				maxCol = col
+			}
+		}
+	}
+	if minCol != 1 || maxCol != 7 {
+		t.Errorf("slope: columns: got %d..%d, want %d..%d\n", minCol, maxCol, 1, 7)
+	}
+
+	// the same input gives the same rivers
+	again := hexg.GenerateRivers(elevation, hexg.RiverParams{Sources: 1, SeaLevel: 2})
+	if fmt.Sprint(again.Edges()) != fmt.Sprint(rl.Edges()) {
+		t.Errorf("slope: rivers are not reproducible\n")
+	}
+
+	// crossing the river costs more
+	e := rl.Edges()[0]
+	hexes := e.Hexes()
+	cost := rl.CrossingCost(nil, 5)
+	if got := cost(hexes[0], hexes[1]); got != 6 {
+		t.Errorf("cost: got %g, want %g\n", got, 6.0)
+	}
+	if got := rl.CrossingCost(nil, -1)(hexes[1], hexes[0]); got >= 0 {
+		t.Errorf("cost: impassable: got %g, want < 0\n", got)
+	}
+}
+
+func TestGenerateRivers_Tributaries(t *testing.T) {
+	// a valley along row 4 that slopes down to the east
+	l := hexg.NewVerticalEvenQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	gs := l.OffsetRect(0, 12, 0, 8)
+	elevation := hexg.NewGrid(gs, func(h hexg.Hex) float64 {
+		oc := l.HexToOffsetCoord(h)
+		return 3*math.Abs(float64(oc.Row-4)) + float64(12-oc.Col)
+	})
+
+	rl := hexg.GenerateRivers(elevation, hexg.RiverParams{Sources: 6, SeaLevel: 0})
+	most := 0
+	for _, flow := range rl.Flow {
+		most = max(most, flow)
+	}
+	if most < 2 {
+		t.Errorf("tributaries: largest flow: got %d, want at least %d\n", most, 2)
+	}
+	if len(rl.Lakes) != 0 {
+		t.Errorf("tributaries: lakes: got %d, want %d\n", len(rl.Lakes), 0)
+	}
+}
+
+func TestGenerateRivers_Basin(t *testing.T) {
+	// a bowl with no outlet drains into a lake in the middle
+	center := hexg.NewHex(0, 0, 0)
+	elevation := hexg.NewGrid(hexg.HexagonalGrid(4), func(h hexg.Hex) float64 {
+		return float64(h.Distance(center))
+	})
+	rl := hexg.GenerateRivers(elevation, hexg.RiverParams{Sources: 3, SeaLevel: -1})
+	if len(rl.Lakes) != 1 || !rl.Lakes.Contains(center) {
+		t.Errorf("basin: lakes: got %v, want %v\n", rl.Lakes.Hexes(), []hexg.Hex{center})
+	}
+	if len(rl.Flow) == 0 {
+		t.Errorf("basin: no rivers\n")
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// The samplers take a math/rand/v2 Source so that callers control the seed.
+// Hexes are always visited in sorted order (see GridStore.Hexes), so the
+// same source and hexes always give the same sample.
+
+// RandomHex returns a hex chosen uniformly at random from the store.
+// Returns ErrNoHexes if the store is empty.
+func RandomHex(src rand.Source, gs GridStore) (Hex, error) {
+	if len(gs) == 0 {
+		return Hex{}, ErrNoHexes
+	}
+	hexes := gs.Hexes()
+	return hexes[rand.New(src).IntN(len(hexes))], nil
+}
+
+// SampleUniform returns n different hexes chosen uniformly at random from the store.
+// It returns every hex, in random order, if the store has n or fewer hexes.
+func SampleUniform(src rand.Source, gs GridStore, n int) []Hex {
+	hexes := gs.Hexes()
+	rng := rand.New(src)
+	// a partial Fisher-Yates shuffle
+	n = max(0, min(n, len(hexes)))
+	for i := 0; i < n; i++ {
+		j := i + rng.IntN(len(hexes)-i)
+		hexes[i], hexes[j] = hexes[j], hexes[i]
+	}
+	return hexes[:n]
+}
+
+// PoissonDisk returns up to n hexes chosen at random from the store, with
+// every pair at least minDistance apart (by Hex.Distance).
+// If n is zero or less, it keeps adding hexes until no more fit.
+//
+// It tries the hexes in random order and keeps each one that is far enough
+// from the hexes already kept, so it may return fewer than n hexes when the
+// spacing doesn't allow n.
+func PoissonDisk(src rand.Source, gs GridStore, minDistance, n int) []Hex {
+	candidates := SampleUniform(src, gs, len(gs))
+	var samples []Hex
+	for _, h := range candidates {
+		if n > 0 && len(samples) >= n {
+			break
+		}
+		ok := true
+		for _, s := range samples {
+			if h.Distance(s) < minDistance {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			samples = append(samples, h)
+		}
+	}
+	return samples
+}
+
+// SampleWeighted returns up to n different hexes from the grid, chosen at
+// random with a probability proportional to the weight of each cell.
+// Cells with a weight of zero or less are never chosen.
+//
+// It uses the Efraimidis-Spirakis method: every cell gets the key u^(1/weight)
+// for a random u, and the cells with the largest keys are chosen.
+func SampleWeighted[T any](src rand.Source, g Grid[T], weight func(h Hex, value T) float64, n int) []Hex {
+	type keyed struct {
+		hex Hex
+		key float64
+	}
+	rng := rand.New(src)
+	var cells []keyed
+	for _, cell := range g.Cells() {
+		w := weight(cell.Hex, cell.Value)
+		if !(w > 0) || math.IsInf(w, 1) {
+			continue
+		}
+		// compare logs to avoid underflow with small weights
+		cells = append(cells, keyed{hex: cell.Hex, key: math.Log(1-rng.Float64()) / w})
+	}
+	slices.SortStableFunc(cells, func(a, b keyed) int {
+		return cmp.Compare(b.key, a.key)
+	})
+	n = max(0, min(n, len(cells)))
+	samples := make([]Hex, n)
+	for i := range samples {
+		samples[i] = cells[i].hex
+	}
+	return samples
+}
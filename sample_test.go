@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestRandomHex(t *testing.T) {
+	gs := hexg.HexagonalGrid(1)
+
+	if _, err := hexg.RandomHex(rand.NewPCG(1, 2), hexg.GridStore{}); !errors.Is(err, hexg.ErrNoHexes) {
+		t.Errorf("empty: got %v, want %v\n", err, hexg.ErrNoHexes)
+	}
+
+	a, _ := hexg.RandomHex(rand.NewPCG(1, 2), gs)
+	b, _ := hexg.RandomHex(rand.NewPCG(1, 2), gs)
+	if a != b {
+		t.Errorf("seed: got %v and %v from the same seed\n", a, b)
+	}
+
+	// every hex is about equally likely
+	src := rand.NewPCG(3, 4)
+	counts := map[hexg.Hex]int{}
+	for i := 0; i < 7000; i++ {
+		h, err := hexg.RandomHex(src, gs)
+		if err != nil || !gs.Contains(h) {
+			t.Fatalf("uniform: got %v %v\n", h, err)
+		}
+		counts[h]++
+	}
+	for h, n := range counts {
+		if n < 850 || n > 1150 {
+			t.Errorf("uniform: %v: got %d, want about %d\n", h, n, 1000)
+		}
+	}
+	if len(counts) != len(gs) {
+		t.Errorf("uniform: got %d hexes, want %d\n", len(counts), len(gs))
+	}
+}
+
+func TestSampleUniform(t *testing.T) {
+	gs := hexg.HexagonalGrid(3)
+
+	first := hexg.SampleUniform(rand.NewPCG(5, 6), gs, 10)
+	second := hexg.SampleUniform(rand.NewPCG(5, 6), gs, 10)
+	if fmt.Sprint(first) != fmt.Sprint(second) {
+		t.Errorf("seed: got %v and %v from the same seed\n", first, second)
+	}
+	if len(first) != 10 {
+		t.Errorf("len: got %d, want %d\n", len(first), 10)
+	}
+	seen := hexg.GridStore{}
+	for _, h := range first {
+		if !gs.Contains(h) || seen.Contains(h) {
+			t.Errorf("sample: %v: not in store or duplicated\n", h)
+		}
+		seen.Add(h)
+	}
+	if got := len(hexg.SampleUniform(rand.NewPCG(5, 6), gs, 100)); got != len(gs) {
+		t.Errorf("all: got %d, want %d\n", got, len(gs))
+	}
+}
+
+func TestPoissonDisk(t *testing.T) {
+	l := hexg.NewTribeNetLayout()
+	gs := l.OffsetRect(0, 29, 0, 20)
+
+	for _, tc := range []struct {
+		id          int
+		minDistance int
+		n           int
+	}{
+		{id: 1, minDistance: 4, n: 10},
+		{id: 2, minDistance: 6, n: 0},
+	} {
+		samples := hexg.PoissonDisk(rand.NewPCG(7, 8), gs, tc.minDistance, tc.n)
+		if tc.n > 0 && len(samples) != tc.n {
+			t.Errorf("%d: len: got %d, want %d\n", tc.id, len(samples), tc.n)
+		}
+		for i, a := range samples {
+			for _, b := range samples[i+1:] {
+				if a.Distance(b) < tc.minDistance {
+					t.Errorf("%d: %v and %v: distance %d, want at least %d\n", tc.id, a, b, a.Distance(b), tc.minDistance)
+				}
+			}
+		}
+		if tc.n > 0 {
+			continue
+		}
+		// with no limit, every hex is too close to a sample to add another
+		for _, h := range gs {
+			near := false
+			for _, s := range samples {
+				near = near || h.Distance(s) < tc.minDistance
+			}
+			if !near {
+				t.Errorf("%d: %v: room for another sample\n", tc.id, h)
+			}
+		}
+	}
+
+	again := hexg.PoissonDisk(rand.NewPCG(7, 8), gs, 4, 10)
+	if fmt.Sprint(again) != fmt.Sprint(hexg.PoissonDisk(rand.NewPCG(7, 8), gs, 4, 10)) {
+		t.Errorf("seed: different samples from the same seed\n")
+	}
+}
+
+func TestSampleWeighted(t *testing.T) {
+	light, heavy, barren := hexg.NewHex(0, 0, 0), hexg.NewHex(1, 0, -1), hexg.NewHex(2, 0, -2)
+	g := hexg.Grid[float64]{}
+	g.Set(light, 1)
+	g.Set(heavy, 9)
+	g.Set(barren, 0)
+	weight := func(h hexg.Hex, value float64) float64 { return value }
+
+	src := rand.NewPCG(9, 10)
+	counts := map[hexg.Hex]int{}
+	for i := 0; i < 1000; i++ {
+		samples := hexg.SampleWeighted(src, g, weight, 1)
+		if len(samples) != 1 {
+			t.Fatalf("len: got %d, want %d\n", len(samples), 1)
+		}
+		counts[samples[0]]++
+	}
+	if counts[barren] != 0 {
+		t.Errorf("barren: got %d, want %d\n", counts[barren], 0)
+	}
+	if counts[heavy] < 850 || counts[heavy] > 950 {
+		t.Errorf("heavy: got %d, want about %d\n", counts[heavy], 900)
+	}
+
+	// hexes with no weight are left out even when more are asked for
+	if got := hexg.SampleWeighted(rand.NewPCG(9, 10), g, weight, 5); len(got) != 2 {
+		t.Errorf("all: got %d, want %d\n", len(got), 2)
+	}
+}
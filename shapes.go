@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+// Shape builders from the "Map shapes" section of the Red Blob Games guide.
+//
+// Every builder takes an anchor. The shape is built around (0,0,0) exactly
+// like the loops in the guide and then translated so that (0,0,0) lands on
+// the anchor. That makes the shapes easy to compare with the diagrams and
+// lets callers place them anywhere on a map.
+
+// Axes_e identifies the two cube axes that bound a parallelogram.
+//
+// Cube coordinates have three axes but a parallelogram is bounded by two of
+// them; the third coordinate is whatever makes q + r + s zero. Picking a
+// different pair gives one of the three orientations of parallelogram.
+type Axes_e int
+
+const (
+	// AxesQR bounds q and r. On a flat-top layout, the q sides run
+	// up and down and the r sides slope down to the right.
+	AxesQR Axes_e = iota
+	// AxesSQ bounds s and q.
+	AxesSQ
+	// AxesRS bounds r and s.
+	AxesRS
+)
+
+// String implements the Stringer interface.
+func (a Axes_e) String() string {
+	switch a {
+	case AxesQR:
+		return "qr"
+	case AxesSQ:
+		return "sq"
+	case AxesRS:
+		return "rs"
+	}
+	return "?"
+}
+
+// Parallelogram returns the hexes in a parallelogram anchored at a hex.
+//
+// The first axis of the pair ranges from min1 to max1 and the second axis
+// ranges from min2 to max2, both inclusive and relative to the anchor.
+// For example, with AxesSQ, s ranges over min1..max1 and q over min2..max2.
+func Parallelogram(anchor Hex, axes Axes_e, min1, max1, min2, max2 int) GridStore {
+	gs := GridStore{}
+	for a := min1; a <= max1; a++ {
+		for b := min2; b <= max2; b++ {
+			var h Hex
+			switch axes {
+			case AxesQR:
+				h = Hex{q: a, r: b, s: -a - b}
+			case AxesSQ:
+				h = Hex{q: b, r: -a - b, s: a}
+			case AxesRS:
+				h = Hex{q: -a - b, r: a, s: b}
+			default:
+				return gs
+			}
+			gs.Add(anchor.Add(h))
+		}
+	}
+	return gs
+}
+
+// Triangle_e identifies the direction that a triangle points.
+//
+// The names come from the pointy-top diagrams in the guide. Flat-top
+// layouts (all the layouts that implement Layout_i) turn them a quarter
+// turn counterclockwise, so TriangleDown points right (east) and TriangleUp
+// points left (west).
+type Triangle_e int
+
+const (
+	// TriangleDown has corners at (0,0,0), (size,0,-size), and (0,size,-size).
+	TriangleDown Triangle_e = iota
+	// TriangleUp has corners at (size,0,-size), (0,size,-size), and (size,size,-2*size).
+	// Its corners are the far corners of a TriangleDown of the same size;
+	// this is the shape that TriagonalGrid returns.
+	TriangleUp
+)
+
+// String implements the Stringer interface.
+func (t Triangle_e) String() string {
+	switch t {
+	case TriangleDown:
+		return "down"
+	case TriangleUp:
+		return "up"
+	}
+	return "?"
+}
+
+// Triangle returns the hexes in a triangle anchored at a hex.
+// Each side of the triangle has size + 1 hexes.
+// The corners are listed on TriangleDown and TriangleUp, relative to the anchor.
+func Triangle(anchor Hex, direction Triangle_e, size int) GridStore {
+	gs := GridStore{}
+	for q := 0; q <= size; q++ {
+		r1, r2 := 0, size-q
+		if direction == TriangleUp {
+			r1, r2 = size-q, size
+		} else if direction != TriangleDown {
+			return gs
+		}
+		for r := r1; r <= r2; r++ {
+			gs.Add(anchor.Add(NewHexFromAxialCoords(q, r)))
+		}
+	}
+	return gs
+}
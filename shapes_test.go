@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+// concise returns the hexes in the grid, in order, as concise strings.
+func concise(gs hexg.GridStore) string {
+	var list []string
+	for _, h := range gs.Hexes() {
+		list = append(list, h.ConciseString())
+	}
+	return strings.Join(list, " ")
+}
+
+func TestParallelogram(t *testing.T) {
+	origin := hexg.NewHex(0, 0, 0)
+	anchor := hexg.NewHex(3, -5, 2)
+
+	for _, tc := range []struct {
+		id     int
+		anchor hexg.Hex
+		axes   hexg.Axes_e
+		min1   int
+		max1   int
+		min2   int
+		max2   int
+		expect string
+	}{
+		// the three orientations from the guide, with two hexes on each side
+		{id: 1, anchor: origin, axes: hexg.AxesQR, min1: 0, max1: 1, min2: 0, max2: 1, expect: "+0+0+0 +1+0-1 +0+1-1 +1+1-2"},
+		{id: 2, anchor: origin, axes: hexg.AxesSQ, min1: 0, max1: 1, min2: 0, max2: 1, expect: "+1-2+1 +0-1+1 +1-1+0 +0+0+0"},
+		{id: 3, anchor: origin, axes: hexg.AxesRS, min1: 0, max1: 1, min2: 0, max2: 1, expect: "-1+0+1 +0+0+0 -2+1+1 -1+1+0"},
+		// ranges are relative to the anchor
+		{id: 4, anchor: anchor, axes: hexg.AxesQR, min1: -1, max1: 0, min2: 0, max2: 0, expect: "+2-5+3 +3-5+2"},
+		{id: 5, anchor: anchor, axes: hexg.AxesRS, min1: 0, max1: 0, min2: 0, max2: 2, expect: "+1-5+4 +2-5+3 +3-5+2"},
+		// empty ranges give empty grids
+		{id: 6, anchor: anchor, axes: hexg.AxesSQ, min1: 1, max1: 0, min2: 0, max2: 2, expect: ""},
+	} {
+		gs := hexg.Parallelogram(tc.anchor, tc.axes, tc.min1, tc.max1, tc.min2, tc.max2)
+		if got := concise(gs); got != tc.expect {
+			t.Errorf("%d: %s: got %q, want %q\n", tc.id, tc.axes, got, tc.expect)
+		}
+	}
+
+	// the qr orientation matches the layout's grid
+	l := hexg.NewVerticalEvenQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	if got, want := concise(hexg.Parallelogram(origin, hexg.AxesQR, -2, 3, 1, 4)), concise(l.ParallelogramGrid(-2, 1, 3, 4)); got != want {
+		t.Errorf("qr: got %q, want %q\n", got, want)
+	}
+}
+
+func TestTriangle(t *testing.T) {
+	origin := hexg.NewHex(0, 0, 0)
+
+	for _, tc := range []struct {
+		id        int
+		anchor    hexg.Hex
+		direction hexg.Triangle_e
+		size      int
+		expect    string
+	}{
+		{id: 1, anchor: origin, direction: hexg.TriangleDown, size: 0, expect: "+0+0+0"},
+		// the two triangles from the guide, with three hexes on each side
+		{id: 2, anchor: origin, direction: hexg.TriangleDown, size: 2, expect: "+0+0+0 +1+0-1 +2+0-2 +0+1-1 +1+1-2 +0+2-2"},
+		{id: 3, anchor: origin, direction: hexg.TriangleUp, size: 2, expect: "+2+0-2 +1+1-2 +2+1-3 +0+2-2 +1+2-3 +2+2-4"},
+		{id: 4, anchor: hexg.NewHex(-1, 1, 0), direction: hexg.TriangleDown, size: 1, expect: "-1+1+0 +0+1-1 -1+2-1"},
+	} {
+		gs := hexg.Triangle(tc.anchor, tc.direction, tc.size)
+		if got := concise(gs); got != tc.expect {
+			t.Errorf("%d: %s: got %q, want %q\n", tc.id, tc.direction, got, tc.expect)
+		}
+		if want := (tc.size + 1) * (tc.size + 2) / 2; len(gs) != want {
+			t.Errorf("%d: %s: len: got %d, want %d\n", tc.id, tc.direction, len(gs), want)
+		}
+	}
+
+	// the up triangle matches the layout's grid
+	l := hexg.NewVerticalOddQLayout(hexg.NewPoint(1, 1), hexg.NewPoint(0, 0))
+	if got, want := concise(hexg.Triangle(origin, hexg.TriangleUp, 4)), concise(l.TriagonalGrid(4)); got != want {
+		t.Errorf("up: got %q, want %q\n", got, want)
+	}
+
+	// on a flat-top layout, a down triangle points right and an up triangle points left
+	for _, tc := range []struct {
+		direction hexg.Triangle_e
+		apex      hexg.Hex
+	}{
+		{direction: hexg.TriangleDown, apex: hexg.NewHex(4, 0, -4)},
+		{direction: hexg.TriangleUp, apex: hexg.NewHex(0, 4, -4)},
+	} {
+		var apex hexg.Hex
+		for n, h := range hexg.Triangle(origin, tc.direction, 4).Hexes() {
+			if p := l.HexToPixel(h); n == 0 || (tc.direction == hexg.TriangleDown && p.X > l.HexToPixel(apex).X) || (tc.direction == hexg.TriangleUp && p.X < l.HexToPixel(apex).X) {
+				apex = h
+			}
+		}
+		if apex != tc.apex {
+			t.Errorf("%s: apex: got %v, want %v\n", tc.direction, apex, tc.apex)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"math"
+)
+
+// Superhex is a tiling of the grid by hexagons of a fixed radius.
+//
+// Every hexagon in the tiling is a superhex. The superhexes form a coarser
+// hex grid of their own, so a superhex is also a Hex and can be grouped into
+// superhexes again for more levels. With radius 1, each superhex holds 7
+// hexes (an aperture-7 cluster); with radius R, it holds 3R²+3R+1 hexes.
+//
+// The centers of the superhexes are the lattice i*A + j*B, where
+// A = (2R+1, -R, -R-1) and B is A rotated 60° to the right.
+// Superhex (i, j) at the coarse level is the Hex with axial coordinates (i, j).
+type Superhex struct {
+	radius int
+	a, b   Hex // lattice vectors
+}
+
+// NewSuperhex returns a tiling by hexagons of the radius.
+// The radius must be at least 1.
+func NewSuperhex(radius int) Superhex {
+	if radius < 1 {
+		panic("assert(radius >= 1)")
+	}
+	a := Hex{q: 2*radius + 1, r: -radius, s: -radius - 1}
+	return Superhex{radius: radius, a: a, b: a.RotateRight()}
+}
+
+// Radius returns the radius of the superhexes.
+func (s Superhex) Radius() int {
+	return s.radius
+}
+
+// Size returns the number of hexes in a superhex.
+func (s Superhex) Size() int {
+	return 3*s.radius*s.radius + 3*s.radius + 1
+}
+
+// Center returns the center of the superhex at the next level down.
+func (s Superhex) Center(parent Hex) Hex {
+	return s.a.Multiply(parent.q).Add(s.b.Multiply(parent.r))
+}
+
+// Parent returns the superhex that contains the hex.
+func (s Superhex) Parent(h Hex) Hex {
+	// solve h = i*A + j*B in axial coordinates for real i and j,
+	// then check the lattice points around the solution.
+	det := float64(s.a.q*s.b.r - s.b.q*s.a.r)
+	i := (float64(h.q*s.b.r) - float64(s.b.q*h.r)) / det
+	j := (float64(s.a.q*h.r) - float64(h.q*s.a.r)) / det
+	i0, j0 := int(math.Floor(i)), int(math.Floor(j))
+	for di := -1; di <= 2; di++ {
+		for dj := -1; dj <= 2; dj++ {
+			parent := NewHexFromAxialCoords(i0+di, j0+dj)
+			if h.Distance(s.Center(parent)) <= s.radius {
+				return parent
+			}
+		}
+	}
+	panic("assert(superhex found)")
+}
+
+// Children returns the hexes in the superhex.
+func (s Superhex) Children(parent Hex) GridStore {
+	center := s.Center(parent)
+	gs := GridStore{}
+	for _, h := range HexagonalGrid(s.radius) {
+		gs.Add(center.Add(h))
+	}
+	return gs
+}
+
+// ParentAt returns the superhex that contains the hex, levels up.
+// Level 0 is the hex itself and level 1 is its parent.
+func (s Superhex) ParentAt(h Hex, level int) Hex {
+	for ; level > 0; level-- {
+		h = s.Parent(h)
+	}
+	return h
+}
+
+// CenterAt returns the center of the superhex, levels down.
+// Level 0 is the superhex itself and level 1 is its center.
+func (s Superhex) CenterAt(parent Hex, level int) Hex {
+	for ; level > 0; level-- {
+		parent = s.Center(parent)
+	}
+	return parent
+}
+
+// Descendants returns the hexes in the superhex, levels down.
+// Level 1 is the same as Children.
+func (s Superhex) Descendants(parent Hex, level int) GridStore {
+	gs := GridStore{}
+	gs.Add(parent)
+	for ; level > 0; level-- {
+		next := GridStore{}
+		for _, h := range gs {
+			for key, child := range s.Children(h) {
+				next[key] = child
+			}
+		}
+		gs = next
+	}
+	return gs
+}
+
+// Aggregate returns a grid with a value for every superhex that has children
+// in the grid. The value is the result of reducing the values of the children
+// that are in the grid. The values are passed in sorted hex order, so
+// reducers like Majority that depend on the order are deterministic.
+func Aggregate[T, U any](s Superhex, g Grid[T], reduce func(values []T) U) Grid[U] {
+	children := map[Hex][]T{}
+	for _, cell := range g.Cells() {
+		parent := s.Parent(cell.Hex)
+		children[parent] = append(children[parent], cell.Value)
+	}
+	result := Grid[U]{}
+	for parent, values := range children {
+		result.Set(parent, reduce(values))
+	}
+	return result
+}
+
+// Expand returns a grid where every child of every superhex in the grid
+// has the value of the superhex.
+func Expand[T any](s Superhex, g Grid[T]) Grid[T] {
+	result := Grid[T]{}
+	for _, cell := range g {
+		for _, child := range s.Children(cell.Hex) {
+			result.Set(child, cell.Value)
+		}
+	}
+	return result
+}
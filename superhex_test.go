@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestSuperhex(t *testing.T) {
+	origin := hexg.NewHex(0, 0, 0)
+
+	for _, radius := range []int{1, 2, 3} {
+		s := hexg.NewSuperhex(radius)
+		if got, want := len(s.Children(origin)), 3*radius*radius+3*radius+1; got != want || s.Size() != want {
+			t.Errorf("%d: size: got %d %d, want %d\n", radius, got, s.Size(), want)
+		}
+		if s.Parent(origin) != origin || s.Center(origin) != origin {
+			t.Errorf("%d: origin: got %v %v, want %v\n", radius, s.Parent(origin), s.Center(origin), origin)
+		}
+
+		// every hex is in the children of its parent, and nowhere else
+		counts := map[hexg.Hex]int{}
+		for _, h := range hexg.HexagonalGrid(15) {
+			parent := s.Parent(h)
+			if !s.Children(parent).Contains(h) {
+				t.Errorf("%d: %v: not a child of %v\n", radius, h, parent)
+			}
+			if h.Distance(s.Center(parent)) > radius {
+				t.Errorf("%d: %v: too far from center %v\n", radius, h, s.Center(parent))
+			}
+			counts[parent]++
+		}
+		if got := counts[origin]; got != s.Size() {
+			t.Errorf("%d: origin: children: got %d, want %d\n", radius, got, s.Size())
+		}
+
+		// the superhexes form a hex grid: neighboring superhexes are neighbors
+		for direction := 0; direction < 6; direction++ {
+			if got, want := s.Center(hexg.Direction(direction)).Length(), 2*radius+1; got != want {
+				t.Errorf("%d: neighbor %d: distance: got %d, want %d\n", radius, direction, got, want)
+			}
+		}
+	}
+
+	// multiple levels
+	s := hexg.NewSuperhex(1)
+	h := hexg.NewHex(17, -5, -12)
+	grandparent := s.ParentAt(h, 2)
+	if grandparent != s.Parent(s.Parent(h)) {
+		t.Errorf("levels: got %v, want %v\n", grandparent, s.Parent(s.Parent(h)))
+	}
+	if s.ParentAt(h, 0) != h {
+		t.Errorf("levels: 0: got %v, want %v\n", s.ParentAt(h, 0), h)
+	}
+	descendants := s.Descendants(grandparent, 2)
+	if len(descendants) != 49 || !descendants.Contains(h) {
+		t.Errorf("levels: descendants: got %d %v, want %d %v\n", len(descendants), descendants.Contains(h), 49, true)
+	}
+	if center := s.CenterAt(grandparent, 2); !descendants.Contains(center) || s.ParentAt(center, 2) != grandparent {
+		t.Errorf("levels: center: %v not in %v\n", center, grandparent)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	s := hexg.NewSuperhex(1)
+	origin := hexg.NewHex(0, 0, 0)
+	neighbor := hexg.Direction(hexg.N)
+
+	// two superhexes: one mostly forest and one mostly water
+	gs := s.Children(origin)
+	for key, h := range s.Children(neighbor) {
+		gs[key] = h
+	}
+	terrain := hexg.NewGrid(gs, func(h hexg.Hex) string {
+		if s.Parent(h) == origin {
+			if h == s.Center(origin) {
+				return "water"
+			}
+			return "forest"
+		}
+		if h == s.Center(neighbor) {
+			return "forest"
+		}
+		return "water"
+	})
+	population := hexg.NewGrid(gs, func(h hexg.Hex) int { return 10 })
+
+	majority := hexg.Aggregate(s, terrain, hexg.Majority[string])
+	if len(majority) != 2 {
+		t.Fatalf("majority: len: got %d, want %d\n", len(majority), 2)
+	}
+	if got, _ := majority.Get(origin); got != "forest" {
+		t.Errorf("majority: origin: got %q, want %q\n", got, "forest")
+	}
+	if got, _ := majority.Get(neighbor); got != "water" {
+		t.Errorf("majority: neighbor: got %q, want %q\n", got, "water")
+	}
+	if got, _ := hexg.Aggregate(s, population, hexg.Sum[int]).Get(origin); got != 70 {
+		t.Errorf("sum: got %d, want %d\n", got, 70)
+	}
+
+	// expanding sets every child to the value of its parent
+	expanded := hexg.Expand(s, majority)
+	if len(expanded) != len(gs) {
+		t.Fatalf("expand: len: got %d, want %d\n", len(expanded), len(gs))
+	}
+	for _, cell := range expanded {
+		if want, _ := majority.Get(s.Parent(cell.Hex)); cell.Value != want {
+			t.Errorf("expand: %v: got %q, want %q\n", cell.Hex, cell.Value, want)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+// Package svg renders hex grids as SVG images.
+//
+// The renderer draws every hex as a polygon from the corners that the
+// layout returns, so the image lines up with HexToPixel, PixelToHex and
+// the rest of the layout's geometry.
+package svg
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/maloquacious/hexg"
+)
+
+// Style is the paint for one hex.
+// Empty fields are left out of the SVG.
+type Style struct {
+	// Fill is the color of the inside of the hex, such as "#2e8b57" or "none".
+	Fill string
+
+	// Stroke is the color of the outline of the hex.
+	Stroke string
+
+	// StrokeWidth is the width of the outline in pixels.
+	StrokeWidth float64
+}
+
+// DefaultStyle is the style of every hex when Options.Style is nil.
+var DefaultStyle = Style{Fill: "#ffffff"}
+
+// Options are the options for a Renderer.
+type Options struct {
+	// Style returns the style for the hex.
+	// If it is nil, every hex has DefaultStyle.
+	Style func(h hexg.Hex) Style
+
+	// Label returns the text to draw at the center of the hex.
+	// Hexes with an empty label are not labeled. If it is nil, there are no labels.
+	// See OffsetLabels, CubeLabels and SchemeLabels.
+	Label func(h hexg.Hex) string
+
+	// FontSize is the size of the labels in pixels.
+	// Defaults to one sixth of the height of a hex.
+	FontSize float64
+
+	// GridLines draws the edges of the hexes on top of the fills.
+	// Each edge is drawn once, even when it is shared by two hexes.
+	GridLines bool
+
+	// GridStroke is the color of the grid lines. Defaults to "#333333".
+	GridStroke string
+
+	// GridStrokeWidth is the width of the grid lines in pixels.
+	// Defaults to one fiftieth of the width of a hex.
+	GridStrokeWidth float64
+
+	// Margin is the space in pixels around the bounds of the hexes in the viewBox.
+	Margin float64
+
+	// Width and Height set the size of the image.
+	// If they are zero, the image has no size and scales to fit its container.
+	Width, Height float64
+}
+
+// Renderer draws hex grids for a layout.
+type Renderer struct {
+	layout hexg.Layout_i
+	opts   Options
+}
+
+// New returns a renderer for the layout.
+func New(l hexg.Layout_i, opts Options) *Renderer {
+	if opts.FontSize <= 0 {
+		opts.FontSize = hexg.HexHeight(l) / 6
+	}
+	if opts.GridStroke == "" {
+		opts.GridStroke = "#333333"
+	}
+	if opts.GridStrokeWidth <= 0 {
+		opts.GridStrokeWidth = hexg.HexWidth(l) / 50
+	}
+	return &Renderer{layout: l, opts: opts}
+}
+
+// Render writes an SVG image of the hexes in the store.
+// The viewBox is the pixel bounds of the hexes plus the margin.
+// Hexes are drawn in sorted order, so the same grid always gives the same image.
+// Returns hexg.ErrNoHexes if the store is empty.
+func (r *Renderer) Render(w io.Writer, gs hexg.GridStore) error {
+	hexes := gs.Hexes()
+	bounds, err := hexg.Bounds(r.layout, hexes...)
+	if err != nil {
+		return err
+	}
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="%s %s %s %s"`,
+		number(bounds.MinPixel.X-r.opts.Margin), number(bounds.MinPixel.Y-r.opts.Margin),
+		number(bounds.Width()+2*r.opts.Margin), number(bounds.Height()+2*r.opts.Margin))
+	if r.opts.Width > 0 {
+		fmt.Fprintf(sb, ` width="%s"`, number(r.opts.Width))
+	}
+	if r.opts.Height > 0 {
+		fmt.Fprintf(sb, ` height="%s"`, number(r.opts.Height))
+	}
+	sb.WriteString(">\n")
+
+	sb.WriteString(`<g class="hexes">` + "\n")
+	for _, h := range hexes {
+		style := DefaultStyle
+		if r.opts.Style != nil {
+			style = r.opts.Style(h)
+		}
+		sb.WriteString(`<polygon points="`)
+		for i, corner := range r.layout.HexCorners(h) {
+			if i > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(number(corner.X) + "," + number(corner.Y))
+		}
+		sb.WriteByte('"')
+		writeStyle(sb, style)
+		sb.WriteString("/>\n")
+	}
+	sb.WriteString("</g>\n")
+
+	if r.opts.GridLines {
+		fmt.Fprintf(sb, `<g class="grid" fill="none" stroke="%s" stroke-width="%s">`+"\n",
+			html.EscapeString(r.opts.GridStroke), number(r.opts.GridStrokeWidth))
+		sb.WriteString(`<path d="`)
+		drawn := map[hexg.Edge]bool{}
+		for _, h := range hexes {
+			for direction := 0; direction < 6; direction++ {
+				e := hexg.NewEdge(h, direction)
+				if drawn[e] {
+					continue
+				}
+				drawn[e] = true
+				ends := hexg.EdgeToPixels(r.layout, e)
+				if len(drawn) > 1 {
+					sb.WriteByte(' ')
+				}
+				fmt.Fprintf(sb, "M%s %sL%s %s", number(ends[0].X), number(ends[0].Y), number(ends[1].X), number(ends[1].Y))
+			}
+		}
+		sb.WriteString(`"/>` + "\n")
+		sb.WriteString("</g>\n")
+	}
+
+	if r.opts.Label != nil {
+		fmt.Fprintf(sb, `<g class="labels" text-anchor="middle" dominant-baseline="central" font-size="%s">`+"\n", number(r.opts.FontSize))
+		for _, h := range hexes {
+			label := r.opts.Label(h)
+			if label == "" {
+				continue
+			}
+			center := r.layout.HexToPixel(h)
+			fmt.Fprintf(sb, `<text x="%s" y="%s">%s</text>`+"\n", number(center.X), number(center.Y), html.EscapeString(label))
+		}
+		sb.WriteString("</g>\n")
+	}
+
+	sb.WriteString("</svg>\n")
+	_, err = io.WriteString(w, sb.String())
+	return err
+}
+
+// writeStyle writes the attributes for the style that are set.
+func writeStyle(sb *strings.Builder, style Style) {
+	if style.Fill != "" {
+		fmt.Fprintf(sb, ` fill="%s"`, html.EscapeString(style.Fill))
+	}
+	if style.Stroke != "" {
+		fmt.Fprintf(sb, ` stroke="%s"`, html.EscapeString(style.Stroke))
+	}
+	if style.StrokeWidth > 0 {
+		fmt.Fprintf(sb, ` stroke-width="%s"`, number(style.StrokeWidth))
+	}
+}
+
+// number formats a coordinate rounded to three decimal places,
+// which is far below a pixel and keeps the SVG small.
+func number(x float64) string {
+	x = math.Round(x*1000) / 1000
+	if x == 0 {
+		// avoid printing negative zero
+		x = 0
+	}
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
+
+// OffsetLabels returns a Label function that labels hexes with their offset
+// column and row in the layout, such as "3,4".
+func OffsetLabels(l hexg.Layout_i) func(h hexg.Hex) string {
+	return func(h hexg.Hex) string {
+		return l.HexToOffsetCoord(h).String()
+	}
+}
+
+// CubeLabels labels hexes with their cube coordinates, such as "1,-2,1".
+func CubeLabels(h hexg.Hex) string {
+	return h.String()
+}
+
+// SchemeLabels returns a Label function that labels hexes with the scheme,
+// such as the TribeNet grid labels from TribeNetLayout.LabelScheme.
+// Hexes that the scheme can't label are left blank.
+func SchemeLabels(scheme hexg.LabelScheme) func(h hexg.Hex) string {
+	return func(h hexg.Hex) string {
+		label, err := scheme.Format(h)
+		if err != nil {
+			return ""
+		}
+		return label
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package svg_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+	"github.com/maloquacious/hexg/svg"
+)
+
+// image is the part of the SVG that the tests look at.
+type image struct {
+	ViewBox string `xml:"viewBox,attr"`
+	Width   string `xml:"width,attr"`
+	Groups  []struct {
+		Class    string `xml:"class,attr"`
+		Polygons []struct {
+			Points string `xml:"points,attr"`
+			Fill   string `xml:"fill,attr"`
+			Stroke string `xml:"stroke,attr"`
+		} `xml:"polygon"`
+		Paths []struct {
+			D string `xml:"d,attr"`
+		} `xml:"path"`
+		Texts []string `xml:"text"`
+	} `xml:"g"`
+}
+
+func render(t *testing.T, l hexg.Layout_i, gs hexg.GridStore, opts svg.Options) image {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	if err := svg.New(l, opts).Render(buf, gs); err != nil {
+		t.Fatalf("render: got %v, want nil\n", err)
+	}
+	var img image
+	if err := xml.Unmarshal(buf.Bytes(), &img); err != nil {
+		t.Fatalf("render: invalid xml: %v\n%s", err, buf.String())
+	}
+	return img
+}
+
+func TestRender(t *testing.T) {
+	l := hexg.NewVerticalOddQLayout(hexg.NewPoint(10, 10), hexg.NewPoint(5, 5))
+	gs := l.OffsetRect(0, 2, 0, 1)
+	img := render(t, l, gs, svg.Options{
+		Style: func(h hexg.Hex) svg.Style {
+			if l.HexToOffsetCoord(h).Col == 0 {
+				return svg.Style{Fill: "blue", Stroke: "black"}
+			}
+			return svg.Style{Fill: "green"}
+		},
+		Label:     svg.OffsetLabels(l),
+		GridLines: true,
+		Margin:    2,
+		Width:     300,
+	})
+
+	bounds, _ := hexg.Bounds(l, gs.Hexes()...)
+	wantViewBox := fmt.Sprintf("%s %s %s %s", num(bounds.MinPixel.X-2), num(bounds.MinPixel.Y-2), num(bounds.Width()+4), num(bounds.Height()+4))
+	if img.ViewBox != wantViewBox {
+		t.Errorf("viewBox: got %q, want %q\n", img.ViewBox, wantViewBox)
+	}
+	if img.Width != "300" {
+		t.Errorf("width: got %q, want %q\n", img.Width, "300")
+	}
+	if len(img.Groups) != 3 {
+		t.Fatalf("groups: got %d, want 3\n", len(img.Groups))
+	}
+
+	// one polygon for every hex, in sorted order, with the corners from the layout
+	hexes, polygons := img.Groups[0], img.Groups[0].Polygons
+	if hexes.Class != "hexes" || len(polygons) != len(gs) {
+		t.Fatalf("hexes: got %q with %d polygons, want %q with %d\n", hexes.Class, len(polygons), "hexes", len(gs))
+	}
+	for i, h := range gs.Hexes() {
+		var points []string
+		for _, corner := range l.HexCorners(h) {
+			points = append(points, num(corner.X)+","+num(corner.Y))
+		}
+		if got, want := polygons[i].Points, strings.Join(points, " "); got != want {
+			t.Errorf("%v: points: got %q, want %q\n", h, got, want)
+		}
+		wantFill, wantStroke := "green", ""
+		if l.HexToOffsetCoord(h).Col == 0 {
+			wantFill, wantStroke = "blue", "black"
+		}
+		if polygons[i].Fill != wantFill || polygons[i].Stroke != wantStroke {
+			t.Errorf("%v: style: got %q %q, want %q %q\n", h, polygons[i].Fill, polygons[i].Stroke, wantFill, wantStroke)
+		}
+	}
+
+	// every edge is drawn once
+	edges := map[hexg.Edge]bool{}
+	for _, h := range gs {
+		for direction := 0; direction < 6; direction++ {
+			edges[hexg.NewEdge(h, direction)] = true
+		}
+	}
+	if grid := img.Groups[1]; grid.Class != "grid" || len(grid.Paths) != 1 || strings.Count(grid.Paths[0].D, "M") != len(edges) {
+		t.Errorf("grid: got %+v, want one path with %d lines\n", grid, len(edges))
+	}
+
+	// labels are in the same order as the hexes
+	var wantLabels []string
+	for _, h := range gs.Hexes() {
+		wantLabels = append(wantLabels, l.HexToOffsetCoord(h).String())
+	}
+	labels := img.Groups[2]
+	if got, want := strings.Join(labels.Texts, " "), strings.Join(wantLabels, " "); labels.Class != "labels" || got != want {
+		t.Errorf("labels: got %q, want %q\n", got, want)
+	}
+}
+
+func TestRender_Labels(t *testing.T) {
+	l := hexg.NewTribeNetLayout()
+	gs := hexg.GridStore{}
+	gs.Add(l.OffsetColRowToHex(0, 0))
+	gs.Add(l.OffsetColRowToHex(-5, 0))
+
+	for _, tc := range []struct {
+		id    int
+		label func(hexg.Hex) string
+		want  []string
+	}{
+		{1, svg.CubeLabels, []string{"0,0,0", "-5,3,2"}},
+		// the tribenet scheme can't label a negative column
+		{2, svg.SchemeLabels(l.LabelScheme()), []string{"AA 0101"}},
+		{3, func(hexg.Hex) string { return "<a&b>" }, []string{"<a&b>", "<a&b>"}},
+	} {
+		img := render(t, l, gs, svg.Options{Label: tc.label})
+		if len(img.Groups) != 2 {
+			t.Errorf("%d: groups: got %d, want 2\n", tc.id, len(img.Groups))
+			continue
+		}
+		if got, want := strings.Join(img.Groups[1].Texts, " "), strings.Join(tc.want, " "); got != want {
+			t.Errorf("%d: got %q, want %q\n", tc.id, got, want)
+		}
+	}
+
+	if err := svg.New(l, svg.Options{}).Render(&bytes.Buffer{}, hexg.GridStore{}); !errors.Is(err, hexg.ErrNoHexes) {
+		t.Errorf("empty: got %v, want %v\n", err, hexg.ErrNoHexes)
+	}
+}
+
+// num formats the number the way the renderer does, rounded to three decimal places.
+func num(x float64) string {
+	return strconv.FormatFloat(math.Round(x*1000)/1000+0, 'f', -1, 64)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Transform is one of the 12 symmetries of the hex grid that keep the origin fixed.
+// The hex is reflected first, if Reflect is set, and then rotated.
+type Transform struct {
+	// Rotation is the number of 60° clockwise turns, 0 to 5.
+	Rotation int
+
+	// Reflect reflects the hex across the q axis before it is rotated.
+	Reflect bool
+}
+
+// Transforms returns the 12 symmetries of the grid: the six rotations
+// and then the six rotations of the reflection.
+// The first transform is the identity.
+func Transforms() []Transform {
+	transforms := make([]Transform, 0, 12)
+	for _, reflect := range []bool{false, true} {
+		for rotation := 0; rotation < 6; rotation++ {
+			transforms = append(transforms, Transform{Rotation: rotation, Reflect: reflect})
+		}
+	}
+	return transforms
+}
+
+// String implements the Stringer interface.
+// It returns the rotation in degrees, with an "r" suffix if the transform reflects.
+func (t Transform) String() string {
+	if t.Reflect {
+		return fmt.Sprintf("%dr", t.rotation()*60)
+	}
+	return fmt.Sprintf("%d", t.rotation()*60)
+}
+
+// Apply returns the hex transformed around the origin.
+func (t Transform) Apply(h Hex) Hex {
+	if t.Reflect {
+		h = h.ReflectQ()
+	}
+	for n := t.rotation(); n > 0; n-- {
+		h = h.RotateRight()
+	}
+	return h
+}
+
+// rotation returns the rotation normalized to the range 0..5.
+func (t Transform) rotation() int {
+	return ((t.Rotation % 6) + 6) % 6
+}
+
+// Template is a shape with payloads, such as a mountain range or a city layout.
+// The hexes in the template are relative to the origin, which is the hex
+// that lands on the anchor when the template is placed.
+type Template[T any] Grid[T]
+
+// NewTemplate returns a template made from the grid.
+// The origin is the hex in the grid that becomes the anchor of the template.
+func NewTemplate[T any](g Grid[T], origin Hex) Template[T] {
+	t := make(Template[T], len(g))
+	for _, cell := range g {
+		h := cell.Hex.Subtract(origin)
+		t[h.Hash()] = Cell[T]{Hex: h, Value: cell.Value}
+	}
+	return t
+}
+
+// Cells returns the cells in the template, relative to the origin,
+// sorted by r and then by q.
+func (t Template[T]) Cells() []Cell[T] {
+	return Grid[T](t).Cells()
+}
+
+// Place returns the cells of the template after it has been transformed
+// and moved so that its origin is on the anchor.
+func (t Template[T]) Place(anchor Hex, transform Transform) Grid[T] {
+	g := make(Grid[T], len(t))
+	for _, cell := range t {
+		g.Set(transform.Apply(cell.Hex).Add(anchor), cell.Value)
+	}
+	return g
+}
+
+// Stamp places the template into the grid, replacing the values of any
+// hexes that are already there.
+func (t Template[T]) Stamp(g Grid[T], anchor Hex, transform Transform) {
+	for key, cell := range t.Place(anchor, transform) {
+		g[key] = cell
+	}
+}
+
+// TemplateMatch is a place where a template matches a grid.
+type TemplateMatch struct {
+	Anchor    Hex
+	Transform Transform
+}
+
+// Match returns every anchor and transform where the template matches the grid.
+// The template matches when every one of its hexes is in the grid and eq
+// returns true for the value in the grid and the value in the template.
+// If eq is nil, only the shape has to match.
+//
+// A template with symmetries matches the same hexes with more than one
+// transform, and every one of them is returned. Matches are sorted by
+// anchor and then in the order of Transforms.
+func Match[T any](g Grid[T], t Template[T], eq func(a, b T) bool) []TemplateMatch {
+	var matches []TemplateMatch
+	cells := t.Cells()
+	if len(cells) == 0 {
+		return matches
+	}
+	gridCells := g.Cells()
+	for _, transform := range Transforms() {
+		// every anchor that puts the first cell of the template on a
+		// matching hex is a candidate; check the rest of the cells there.
+		first := transform.Apply(cells[0].Hex)
+		for _, candidate := range gridCells {
+			if eq != nil && !eq(candidate.Value, cells[0].Value) {
+				continue
+			}
+			anchor := candidate.Hex.Subtract(first)
+			matched := true
+			for _, cell := range cells[1:] {
+				value, ok := g.Get(transform.Apply(cell.Hex).Add(anchor))
+				if !ok || (eq != nil && !eq(value, cell.Value)) {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				matches = append(matches, TemplateMatch{Anchor: anchor, Transform: transform})
+			}
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b TemplateMatch) int {
+		return compareHexes(a.Anchor, b.Anchor)
+	})
+	return matches
+}
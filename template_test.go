@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestHex_Reflect(t *testing.T) {
+	h := hexg.NewHex(3, -1, -2)
+	for _, tc := range []struct {
+		id   int
+		got  hexg.Hex
+		want hexg.Hex
+	}{
+		{1, h.ReflectQ(), hexg.NewHex(3, -2, -1)},
+		{2, h.ReflectR(), hexg.NewHex(-2, -1, 3)},
+		{3, h.ReflectS(), hexg.NewHex(-1, 3, -2)},
+		{4, h.ReflectQ().ReflectQ(), h},
+		{5, h.RotateRight().RotateLeft(), h},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%d: got %v, want %v\n", tc.id, tc.got, tc.want)
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	transforms := hexg.Transforms()
+	if len(transforms) != 12 {
+		t.Fatalf("transforms: got %d, want 12\n", len(transforms))
+	}
+	// an asymmetric hex has a different image under every transform
+	h := hexg.NewHex(3, -1, -2)
+	if got := transforms[0].Apply(h); got != h {
+		t.Errorf("identity: got %v, want %v\n", got, h)
+	}
+	images := map[hexg.Hex]bool{}
+	for _, tr := range transforms {
+		image := tr.Apply(h)
+		if image.Length() != h.Length() {
+			t.Errorf("%s: length: got %d, want %d\n", tr, image.Length(), h.Length())
+		}
+		images[image] = true
+	}
+	if len(images) != 12 {
+		t.Errorf("images: got %d, want 12\n", len(images))
+	}
+
+	for _, tc := range []struct {
+		id   int
+		tr   hexg.Transform
+		want hexg.Hex
+	}{
+		{1, hexg.Transform{Rotation: 1}, h.RotateRight()},
+		{2, hexg.Transform{Rotation: 7}, h.RotateRight()},
+		{3, hexg.Transform{Rotation: -1}, h.RotateLeft()},
+		{4, hexg.Transform{Reflect: true}, h.ReflectQ()},
+		{5, hexg.Transform{Rotation: 2, Reflect: true}, h.ReflectQ().RotateRight().RotateRight()},
+	} {
+		if got := tc.tr.Apply(h); got != tc.want {
+			t.Errorf("%d: %s: got %v, want %v\n", tc.id, tc.tr, got, tc.want)
+		}
+	}
+}
+
+func TestTemplate_Stamp(t *testing.T) {
+	// an L of three hexes, with the origin at the corner
+	shape := hexg.Grid[string]{}
+	shape.Set(hexg.NewHex(5, 5, -10), "a")
+	shape.Set(hexg.NewHex(6, 5, -11), "b")
+	shape.Set(hexg.NewHex(5, 6, -11), "c")
+	tmpl := hexg.NewTemplate(shape, hexg.NewHex(5, 5, -10))
+
+	g := hexg.NewGrid(hexg.HexagonalGrid(3), func(h hexg.Hex) string { return "." })
+	anchor := hexg.NewHex(1, -1, 0)
+	tr := hexg.Transform{Rotation: 1}
+	tmpl.Stamp(g, anchor, tr)
+
+	for _, tc := range []struct {
+		id   int
+		h    hexg.Hex
+		want string
+	}{
+		{1, anchor, "a"},
+		{2, anchor.Add(hexg.NewHex(1, 0, -1).RotateRight()), "b"},
+		{3, anchor.Add(hexg.NewHex(0, 1, -1).RotateRight()), "c"},
+		{4, hexg.NewHex(3, 0, -3), "."},
+	} {
+		if got, _ := g.Get(tc.h); got != tc.want {
+			t.Errorf("%d: %v: got %q, want %q\n", tc.id, tc.h, got, tc.want)
+		}
+	}
+	if len(g) != len(hexg.HexagonalGrid(3)) {
+		t.Errorf("len: got %d, want %d\n", len(g), len(hexg.HexagonalGrid(3)))
+	}
+}
+
+func TestMatch(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	// a template with distinct values has no symmetries, so it matches once
+	shape := hexg.Grid[int]{}
+	shape.Set(hexg.NewHex(0, 0, 0), 1)
+	shape.Set(hexg.NewHex(1, 0, -1), 2)
+	shape.Set(hexg.NewHex(1, -1, 0), 3)
+	shape.Set(hexg.NewHex(2, -1, -1), 4)
+	tmpl := hexg.NewTemplate(shape, hexg.NewHex(0, 0, 0))
+
+	for _, tc := range []struct {
+		id     int
+		anchor hexg.Hex
+		tr     hexg.Transform
+	}{
+		{1, hexg.NewHex(0, 0, 0), hexg.Transform{}},
+		{2, hexg.NewHex(-1, 1, 0), hexg.Transform{Rotation: 3}},
+		{3, hexg.NewHex(1, 0, -1), hexg.Transform{Rotation: 4, Reflect: true}},
+	} {
+		g := hexg.NewGrid[int](hexg.HexagonalGrid(4), nil)
+		tmpl.Stamp(g, tc.anchor, tc.tr)
+		matches := hexg.Match(g, tmpl, eq)
+		if len(matches) != 1 {
+			t.Errorf("%d: matches: got %v, want 1\n", tc.id, matches)
+			continue
+		}
+		if got := matches[0]; got.Anchor != tc.anchor || got.Transform != tc.tr {
+			t.Errorf("%d: got %v %s, want %v %s\n", tc.id, got.Anchor, got.Transform, tc.anchor, tc.tr)
+		}
+	}
+
+	// a domino in the 7 hexes of a radius 1 hexagon. there are 4 placements
+	// for each direction and every direction comes from 2 transforms.
+	domino := hexg.Grid[int]{}
+	domino.Set(hexg.NewHex(0, 0, 0), 0)
+	domino.Set(hexg.NewHex(1, 0, -1), 0)
+	g := hexg.NewGrid[int](hexg.HexagonalGrid(1), nil)
+	if got := hexg.Match(g, hexg.NewTemplate(domino, hexg.NewHex(0, 0, 0)), nil); len(got) != 48 {
+		t.Errorf("domino: got %d, want 48\n", len(got))
+	}
+
+	// values must match when eq is given
+	g.Set(hexg.NewHex(0, 0, 0), 1)
+	if got := hexg.Match(g, hexg.NewTemplate(domino, hexg.NewHex(0, 0, 0)), eq); len(got) != 24 {
+		t.Errorf("domino: values: got %d, want 24\n", len(got))
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"math"
+)
+
+// Terrain is the generated terrain for a hex.
+type Terrain struct {
+	// Elevation and Moisture are in the range 0..1.
+	Elevation float64
+	Moisture  float64
+
+	// Biome is the name of the first biome that matches the elevation and moisture.
+	// It is empty if no biome matches.
+	Biome string
+}
+
+// Biome maps a range of elevation and moisture to a terrain class.
+type Biome struct {
+	Name string
+
+	// MaxElevation and MaxMoisture are the inclusive upper bounds for the biome.
+	MaxElevation float64
+	MaxMoisture  float64
+}
+
+// DefaultBiomes returns a simple set of biomes, ordered from lowest to highest.
+func DefaultBiomes() []Biome {
+	return []Biome{
+		{Name: "ocean", MaxElevation: 0.30, MaxMoisture: 1},
+		{Name: "desert", MaxElevation: 0.60, MaxMoisture: 0.30},
+		{Name: "grassland", MaxElevation: 0.60, MaxMoisture: 0.60},
+		{Name: "forest", MaxElevation: 0.60, MaxMoisture: 1},
+		{Name: "hills", MaxElevation: 0.80, MaxMoisture: 1},
+		{Name: "mountains", MaxElevation: 1, MaxMoisture: 1},
+	}
+}
+
+// TerrainParams are the parameters for GenerateTerrain.
+// Zero values are replaced by the defaults noted on each field.
+type TerrainParams struct {
+	// Seed selects the noise. The same seed and parameters always give the same terrain.
+	Seed uint64
+
+	// Scale is the size, in pixels, of the coarsest noise features.
+	// Defaults to eight times the width of a hex in the layout.
+	Scale float64
+
+	// Octaves is the number of layers of noise that are added together.
+	// Defaults to 4.
+	Octaves int
+
+	// Persistence is the change in amplitude from one octave to the next.
+	// A negative persistence flips the sign of every other octave.
+	// Defaults to 0.5.
+	Persistence float64
+
+	// Lacunarity is the change in frequency from one octave to the next.
+	// Defaults to 2.
+	Lacunarity float64
+
+	// Biomes are checked in order and the first one that matches is used.
+	// Defaults to DefaultBiomes.
+	Biomes []Biome
+}
+
+// GenerateTerrain returns the terrain for every hex in the store.
+//
+// Elevation and moisture are fractal value noise sampled at the center of
+// each hex (from HexToPixel), so the terrain is the same for a hex no matter
+// what shape the grid is. Moisture uses different noise than elevation.
+func GenerateTerrain(l Layout_i, gs GridStore, params TerrainParams) Grid[Terrain] {
+	if params.Scale <= 0 {
+		params.Scale = 8 * HexWidth(l)
+	}
+	if params.Octaves < 1 {
+		params.Octaves = 4
+	}
+	if params.Persistence == 0 {
+		params.Persistence = 0.5
+	}
+	if params.Lacunarity == 0 {
+		params.Lacunarity = 2
+	}
+	if params.Biomes == nil {
+		params.Biomes = DefaultBiomes()
+	}
+
+	return NewGrid(gs, func(h Hex) Terrain {
+		p := l.HexToPixel(h)
+		t := Terrain{
+			Elevation: fractalNoise(params, 0, p.X, p.Y),
+			Moisture:  fractalNoise(params, 1, p.X, p.Y),
+		}
+		for _, biome := range params.Biomes {
+			if t.Elevation <= biome.MaxElevation && t.Moisture <= biome.MaxMoisture {
+				t.Biome = biome.Name
+				break
+			}
+		}
+		return t
+	})
+}
+
+// fractalNoise returns the sum of the octaves of value noise at the point,
+// normalized to the range 0..1. The channel selects independent noise for the same seed.
+func fractalNoise(params TerrainParams, channel uint64, x, y float64) float64 {
+	frequency, amplitude := 1/params.Scale, 1.0
+	// low and high are the smallest and largest possible sums. a negative
+	// persistence gives negative amplitudes, so the amplitudes can add up to
+	// zero; the first amplitude is 1, so high - low is never less than 1.
+	var sum, low, high float64
+	for octave := 0; octave < params.Octaves; octave++ {
+		// every octave gets its own lattice so that they don't line up
+		seed := params.Seed ^ (channel<<32 | uint64(octave))
+		sum += amplitude * valueNoise(seed, x*frequency, y*frequency)
+		if amplitude < 0 {
+			low += amplitude
+		} else {
+			high += amplitude
+		}
+		frequency *= params.Lacunarity
+		amplitude *= params.Persistence
+	}
+	return (sum - low) / (high - low)
+}
+
+// valueNoise returns smoothly interpolated random values from an integer lattice.
+// The result is in the range 0..1.
+func valueNoise(seed uint64, x, y float64) float64 {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	ix, iy := int64(x0), int64(y0)
+	// smoothstep the fractions so that the noise has no creases at the lattice lines
+	fx, fy := x-x0, y-y0
+	fx, fy = fx*fx*(3-2*fx), fy*fy*(3-2*fy)
+
+	v00, v10 := latticeValue(seed, ix, iy), latticeValue(seed, ix+1, iy)
+	v01, v11 := latticeValue(seed, ix, iy+1), latticeValue(seed, ix+1, iy+1)
+	top := v00 + (v10-v00)*fx
+	bottom := v01 + (v11-v01)*fx
+	return top + (bottom-top)*fy
+}
+
+// latticeValue returns the random value, 0..1, for a lattice point.
+// It hashes the seed and the point with the SplitMix64 finalizer.
+func latticeValue(seed uint64, ix, iy int64) float64 {
+	z := seed + uint64(ix)*0x9e3779b97f4a7c15 + uint64(iy)*0xc2b2ae3d27d4eb4f
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	// use the top 53 bits for a float64 in [0,1)
+	return float64(z>>11) / (1 << 53)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestGenerateTerrain(t *testing.T) {
+	l := hexg.NewTribeNetLayout()
+	gs := l.OffsetRect(0, 29, 0, 20)
+
+	// the same seed and parameters give identical output
+	params := hexg.TerrainParams{Seed: 1234}
+	first := fmt.Sprintf("%v", hexg.GenerateTerrain(l, gs, params).Cells())
+	second := fmt.Sprintf("%v", hexg.GenerateTerrain(l, gs, params).Cells())
+	if first != second {
+		t.Errorf("seed: same seed gave different terrain\n")
+	}
+	if other := fmt.Sprintf("%v", hexg.GenerateTerrain(l, gs, hexg.TerrainParams{Seed: 4321}).Cells()); other == first {
+		t.Errorf("seed: different seeds gave the same terrain\n")
+	}
+
+	terrain := hexg.GenerateTerrain(l, gs, params)
+	if len(terrain) != len(gs) {
+		t.Fatalf("len: got %d, want %d\n", len(terrain), len(gs))
+	}
+	biomes := map[string]int{}
+	for _, cell := range terrain {
+		if !gs.Contains(cell.Hex) {
+			t.Errorf("%v: not in grid\n", cell.Hex)
+		}
+		if !(0 <= cell.Value.Elevation && cell.Value.Elevation <= 1) {
+			t.Errorf("%v: elevation: got %g, want 0..1\n", cell.Hex, cell.Value.Elevation)
+		}
+		if !(0 <= cell.Value.Moisture && cell.Value.Moisture <= 1) {
+			t.Errorf("%v: moisture: got %g, want 0..1\n", cell.Hex, cell.Value.Moisture)
+		}
+		if cell.Value.Elevation == cell.Value.Moisture {
+			t.Errorf("%v: elevation and moisture are the same noise\n", cell.Hex)
+		}
+		biomes[cell.Value.Biome]++
+	}
+	if biomes[""] != 0 {
+		t.Errorf("biomes: %d hexes have no biome\n", biomes[""])
+	}
+	if len(biomes) < 2 {
+		t.Errorf("biomes: got %v, want more than one biome\n", biomes)
+	}
+
+	// terrain depends on the hex, not on the shape of the grid
+	small := hexg.GenerateTerrain(l, l.HexagonalGrid(l.OffsetColRowToHex(10, 10), 3), params)
+	for _, cell := range small {
+		if want, ok := terrain.Get(cell.Hex); !ok {
+			t.Errorf("shape: %v: not in sheet\n", cell.Hex)
+		} else if cell.Value != want {
+			t.Errorf("shape: %v: got %+v, want %+v\n", cell.Hex, cell.Value, want)
+		}
+	}
+
+	// custom biomes are checked in order
+	params.Biomes = []hexg.Biome{
+		{Name: "low", MaxElevation: 0.5, MaxMoisture: 1},
+		{Name: "high", MaxElevation: 1, MaxMoisture: 1},
+	}
+	for _, cell := range hexg.GenerateTerrain(l, gs, params) {
+		want := "high"
+		if cell.Value.Elevation <= 0.5 {
+			want = "low"
+		}
+		if cell.Value.Biome != want {
+			t.Errorf("custom: %v: got %q, want %q\n", cell.Hex, cell.Value.Biome, want)
+		}
+	}
+}
+
+func TestGenerateTerrain_Persistence(t *testing.T) {
+	l := hexg.NewTribeNetLayout()
+	gs := l.OffsetRect(0, 9, 0, 9)
+	// negative amplitudes can add up to zero, which must not make NaN
+	for _, tc := range []struct {
+		id          int
+		persistence float64
+		octaves     int
+	}{
+		{id: 1, persistence: -1, octaves: 2},
+		{id: 2, persistence: -1, octaves: 4},
+		{id: 3, persistence: -0.5, octaves: 5},
+		{id: 4, persistence: 1e-9, octaves: 1},
+	} {
+		params := hexg.TerrainParams{Seed: 99, Persistence: tc.persistence, Octaves: tc.octaves}
+		for _, cell := range hexg.GenerateTerrain(l, gs, params).Cells() {
+			e, m := cell.Value.Elevation, cell.Value.Moisture
+			if !(0 <= e && e <= 1) || !(0 <= m && m <= 1) || cell.Value.Biome == "" {
+				t.Errorf("%d: %v: got %g %g %q, want values in 0..1 and a biome\n", tc.id, cell.Hex, e, m, cell.Value.Biome)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"fmt"
+	"math"
+)
+
+// The triangle grid is the dual of the hex grid. The corners of every
+// triangle are the centers of three hexes that meet at a vertex, so there
+// is exactly one triangle for every vertex and one triangle edge crossing
+// every hex edge.
+//
+// Triangles use coordinates (a, b, c) where a + b + c is 1 or 2.
+// The two sums are the two orientations of triangle. Subtracting 1 from one
+// coordinate of a sum-1 triangle, or from two coordinates of a sum-2 triangle,
+// gives the hexes at its corners.
+
+// DualTriangle is a triangle in the grid that is dual to the hex grid.
+// The zero value is the triangle (1,0,0), which is centered on vertex 0
+// of the origin hex.
+type DualTriangle struct {
+	// a is stored less one so that the zero value is a real triangle.
+	a, b, c int
+}
+
+// NewDualTriangle returns a DualTriangle initialized with triangle coordinates.
+// Panics if a + b + c is not 1 or 2.
+func NewDualTriangle(a, b, c int) DualTriangle {
+	if sum := a + b + c; sum != 1 && sum != 2 {
+		panic("assert (a + b + c == 1 || a + b + c == 2)")
+	}
+	return newDualTriangle(a, b, c)
+}
+
+// newDualTriangle returns the triangle without checking the coordinates.
+func newDualTriangle(a, b, c int) DualTriangle {
+	return DualTriangle{a: a - 1, b: b, c: c}
+}
+
+// coords returns the triangle coordinates a, b and c.
+func (t DualTriangle) coords() (a, b, c int) {
+	return t.a + 1, t.b, t.c
+}
+
+// String implements the Stringer interface.
+// It returns the coordinates formatted as (a,b,c).
+func (t DualTriangle) String() string {
+	a, b, c := t.coords()
+	return fmt.Sprintf("%d,%d,%d", a, b, c)
+}
+
+// Sum returns a + b + c, which is 1 or 2 depending on the orientation of the triangle.
+func (t DualTriangle) Sum() int {
+	a, b, c := t.coords()
+	return a + b + c
+}
+
+// Hexes returns the three hexes whose centers are the corners of the triangle.
+func (t DualTriangle) Hexes() [3]Hex {
+	a, b, c := t.coords()
+	if t.Sum() == 1 {
+		return [3]Hex{
+			{q: a - 1, r: b, s: c},
+			{q: a, r: b - 1, s: c},
+			{q: a, r: b, s: c - 1},
+		}
+	}
+	return [3]Hex{
+		{q: a - 1, r: b - 1, s: c},
+		{q: a - 1, r: b, s: c - 1},
+		{q: a, r: b - 1, s: c - 1},
+	}
+}
+
+// Vertex returns the hex vertex at the center of the triangle.
+func (t DualTriangle) Vertex() Vertex {
+	a, b, c := t.coords()
+	if t.Sum() == 1 {
+		return Vertex{hex: Hex{q: a - 1, r: b, s: c}, corner: 0}
+	}
+	return Vertex{hex: Hex{q: a - 1, r: b, s: c - 1}, corner: 1}
+}
+
+// DualTriangle returns the dual triangle that has the vertex at its center.
+func (v Vertex) DualTriangle() DualTriangle {
+	if v.corner == 0 {
+		return newDualTriangle(v.hex.q+1, v.hex.r, v.hex.s)
+	}
+	return newDualTriangle(v.hex.q+1, v.hex.r, v.hex.s+1)
+}
+
+// Edges returns the hex edges that the sides of the triangle cross.
+// Each side joins the centers of the two hexes that share the edge.
+func (t DualTriangle) Edges() [3]Edge {
+	return t.Vertex().Edges()
+}
+
+// Neighbors returns the three triangles that share a side with the triangle.
+func (t DualTriangle) Neighbors() [3]DualTriangle {
+	var neighbors [3]DualTriangle
+	for i, v := range t.Vertex().Neighbors() {
+		neighbors[i] = v.DualTriangle()
+	}
+	return neighbors
+}
+
+// DualTriangles returns the six triangles that have the center of the hex as a corner.
+// Triangle k is centered on vertex k of the hex.
+func (h Hex) DualTriangles() [6]DualTriangle {
+	var triangles [6]DualTriangle
+	for k := 0; k < 6; k++ {
+		triangles[k] = NewVertex(h, k).DualTriangle()
+	}
+	return triangles
+}
+
+// DualTriangleToPixel returns the screen coordinates of the center of the triangle.
+func DualTriangleToPixel(l Layout_i, t DualTriangle) Point {
+	return VertexToPixel(l, t.Vertex())
+}
+
+// DualTriangleCorners returns the screen coordinates of the corners of the triangle.
+func DualTriangleCorners(l Layout_i, t DualTriangle) [3]Point {
+	var corners [3]Point
+	for i, h := range t.Hexes() {
+		corners[i] = l.HexToPixel(h)
+	}
+	return corners
+}
+
+// PixelToDualTriangle returns the triangle that contains the pixel.
+// A pixel at the center of a hex is a corner of six triangles;
+// it returns one of them, so the hex is always in its Hexes.
+func PixelToDualTriangle(l Layout_i, p Point) DualTriangle {
+	f := l.PixelToFractionalHex(p)
+	fq, fr, fs := math.Floor(f.q), math.Floor(f.r), math.Floor(f.s)
+	a, b, c := int(fq)+1, int(fr)+1, int(fs)+1
+	if a+b+c == 3 {
+		// the fractional parts sum to zero, so the pixel is on a hex center.
+		// drop the coordinate that is furthest into its cell to get a real triangle.
+		dq, dr, ds := f.q-fq, f.r-fr, f.s-fs
+		if dq >= dr && dq >= ds {
+			a--
+		} else if dr >= ds {
+			b--
+		} else {
+			c--
+		}
+	}
+	return newDualTriangle(a, b, c)
+}
+
+// Sub-hex placement divides every hex into six sectors. Sector k is the
+// wedge between the center of the hex and the edge in direction k, so it
+// points at the neighbor in direction k. The sectors are useful for placing
+// several units or markers inside one hex.
+
+// PixelToSector returns the hex that contains the pixel and the sector of the
+// hex that the pixel is in.
+func PixelToSector(l Layout_i, p Point) (h Hex, sector int) {
+	f := l.PixelToFractionalHex(p)
+	h = f.Round()
+	dq, dr, ds := f.q-float64(h.q), f.r-float64(h.r), f.s-float64(h.s)
+	// the sector is the direction that the offset from the center points
+	// along the most. this is done in cube space, where the hex is regular;
+	// the layout is an affine transform, so it doesn't change which wedge
+	// a point is in.
+	best := math.Inf(-1)
+	for k := 0; k < 6; k++ {
+		d := hex_directions[k]
+		if dot := dq*float64(d.q) + dr*float64(d.r) + ds*float64(d.s); dot > best+1e-9 {
+			sector, best = k, dot
+		}
+	}
+	return h, sector
+}
+
+// SectorCorners returns the screen coordinates of the corners of the sector:
+// the center of the hex and the two ends of the edge in the direction.
+func SectorCorners(l Layout_i, h Hex, sector int) [3]Point {
+	ends := EdgeToPixels(l, NewEdge(h, sector))
+	return [3]Point{l.HexToPixel(h), ends[0], ends[1]}
+}
+
+// SectorToPixel returns the screen coordinates of the center of the sector.
+func SectorToPixel(l Layout_i, h Hex, sector int) Point {
+	corners := SectorCorners(l, h, sector)
+	return Point{
+		X: (corners[0].X + corners[1].X + corners[2].X) / 3,
+		Y: (corners[0].Y + corners[1].Y + corners[2].Y) / 3,
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestDualTriangle(t *testing.T) {
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("new: 0,0,0: did not panic\n")
+			}
+		}()
+		hexg.NewDualTriangle(0, 0, 0)
+	}()
+
+	// triangle 1,0,0 has the corners 0,0,0, 1,-1,0 and 1,0,-1
+	tri := hexg.NewDualTriangle(1, 0, 0)
+	if got, want := tri.Hexes(), [3]hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(1, -1, 0), hexg.NewHex(1, 0, -1)}; got != want {
+		t.Errorf("hexes: got %v, want %v\n", got, want)
+	}
+	if got, want := tri.Vertex(), hexg.NewVertex(hexg.NewHex(0, 0, 0), 0); got != want {
+		t.Errorf("vertex: got %v, want %v\n", got, want)
+	}
+	// the zero value is the same triangle
+	if zero := (hexg.DualTriangle{}); zero != tri || zero.Sum() != 1 || zero.String() != "1,0,0" {
+		t.Errorf("zero: got %v with sum %d, want %v with sum 1\n", zero, zero.Sum(), tri)
+	}
+	if got, want := (hexg.Vertex{}).DualTriangle(), (hexg.DualTriangle{}); got != want {
+		t.Errorf("zero: vertex: got %v, want %v\n", got, want)
+	}
+
+	sums := map[int]int{}
+	for _, h := range hexg.HexagonalGrid(2) {
+		seen := map[hexg.DualTriangle]bool{}
+		for k, tri := range h.DualTriangles() {
+			sums[tri.Sum()]++
+			if seen[tri] {
+				t.Errorf("%v: %d: duplicate triangle %v\n", h, k, tri)
+			}
+			seen[tri] = true
+
+			// the triangle and the vertex are the same point in the two grids
+			v := hexg.NewVertex(h, k)
+			if tri.Vertex() != v || v.DualTriangle() != tri {
+				t.Errorf("%v: %d: got %v %v, want %v %v\n", h, k, tri.Vertex(), v.DualTriangle(), v, tri)
+			}
+			if !sameHexes(tri.Hexes(), v.Hexes()) {
+				t.Errorf("%v: %d: hexes: got %v, want %v\n", h, k, tri.Hexes(), v.Hexes())
+			}
+
+			// neighboring triangles share two corners, across a hex edge
+			for i, n := range tri.Neighbors() {
+				shared := 0
+				for _, a := range tri.Hexes() {
+					for _, b := range n.Hexes() {
+						if a == b {
+							shared++
+						}
+					}
+				}
+				edge := tri.Edges()[i].Hexes()
+				if shared != 2 || !containsHex(n.Hexes(), edge[0]) || !containsHex(n.Hexes(), edge[1]) {
+					t.Errorf("%v: neighbor %v: shares %d corners, want the ends of %v\n", tri, n, shared, edge)
+				}
+			}
+		}
+	}
+	if sums[1] == 0 || sums[2] == 0 || sums[1] != sums[2] {
+		t.Errorf("sums: got %v, want equal counts of 1 and 2\n", sums)
+	}
+}
+
+func TestDualTriangle_Pixels(t *testing.T) {
+	for _, l := range []hexg.Layout_i{
+		hexg.NewTribeNetLayout(),
+		hexg.NewVerticalEvenQLayout(hexg.NewPoint(12, 7), hexg.NewPoint(100, -40)),
+	} {
+		for _, h := range hexg.HexagonalGrid(2) {
+			for _, tri := range h.DualTriangles() {
+				center := hexg.DualTriangleToPixel(l, tri)
+				if got := hexg.PixelToDualTriangle(l, center); got != tri {
+					t.Errorf("%s: %v: got %v, want %v\n", l.OffsetType(), tri, got, tri)
+				}
+				// the center of the triangle is the centroid of its corners
+				corners := hexg.DualTriangleCorners(l, tri)
+				centroid := hexg.NewPoint((corners[0].X+corners[1].X+corners[2].X)/3, (corners[0].Y+corners[1].Y+corners[2].Y)/3)
+				if !closeTo(center, centroid) {
+					t.Errorf("%s: %v: center: got %v, want %v\n", l.OffsetType(), tri, center, centroid)
+				}
+			}
+		}
+	}
+}
+
+func TestPixelToDualTriangle_HexCenters(t *testing.T) {
+	for _, l := range []hexg.Layout_i{
+		hexg.NewTribeNetLayout(),
+		hexg.NewVerticalEvenQLayout(hexg.NewPoint(12, 7), hexg.NewPoint(100, -40)),
+	} {
+		for _, h := range hexg.HexagonalGrid(3).Hexes() {
+			tri := hexg.PixelToDualTriangle(l, l.HexToPixel(h))
+			if sum := tri.Sum(); sum != 1 && sum != 2 {
+				t.Errorf("%s: %v: got %v with sum %d, want sum 1 or 2\n", l.OffsetType(), h, tri, sum)
+			}
+			if !containsHex(tri.Hexes(), h) {
+				t.Errorf("%s: %v: got %v with hexes %v, want the center among them\n", l.OffsetType(), h, tri, tri.Hexes())
+			}
+		}
+	}
+}
+
+func TestSectors(t *testing.T) {
+	for _, l := range []hexg.Layout_i{
+		hexg.NewTribeNetLayout(),
+		hexg.NewVerticalOddQLayout(hexg.NewPoint(12, 7), hexg.NewPoint(100, -40)),
+	} {
+		for _, h := range hexg.HexagonalGrid(1) {
+			for sector := 0; sector < 6; sector++ {
+				p := hexg.SectorToPixel(l, h, sector)
+				gotHex, gotSector := hexg.PixelToSector(l, p)
+				if gotHex != h || gotSector != sector {
+					t.Errorf("%s: %v/%d: got %v/%d\n", l.OffsetType(), h, sector, gotHex, gotSector)
+				}
+				// every sector has the center of the hex as its first corner
+				if corners := hexg.SectorCorners(l, h, sector); corners[0] != l.HexToPixel(h) {
+					t.Errorf("%s: %v/%d: first corner: got %v, want the center\n", l.OffsetType(), h, sector, corners[0])
+				}
+			}
+		}
+	}
+}
+
+// sameHexes returns true if the two lists have the same hexes in any order.
+func sameHexes(a, b [3]hexg.Hex) bool {
+	for _, h := range a {
+		if !containsHex(b, h) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsHex returns true if the hex is in the list.
+func containsHex(list [3]hexg.Hex, h hexg.Hex) bool {
+	for _, x := range list {
+		if x == h {
+			return true
+		}
+	}
+	return false
+}
@@ -2,12 +2,6 @@
 
 package hexg
 
-import (
-	"fmt"
-	"strconv"
-	"unicode"
-)
-
 // TribeNet coordinates are in the form "AB 0102":
 // - "A" (grid row) and "B" (grid column) identify a sub-map.
 // - "0102" is the in-submap position: column 01 (1-based) and row 02 (1-based).
@@ -43,6 +37,25 @@ type TribeNetLayout struct {
 	VerticalOddQLayout
 }
 
+// LabelScheme returns the TribeNet sheet scheme for the layout.
+// TribeNet maps are cut into A-Z by A-Z sheets of 30 columns by 21 rows,
+// and labels are printed as "AB 0102".
+func (l TribeNetLayout) LabelScheme() LabelScheme {
+	return l.sheetScheme()
+}
+
+// sheetScheme returns the TribeNet sheet scheme for the layout.
+func (l TribeNetLayout) sheetScheme() SheetScheme {
+	return SheetScheme{
+		Layout:    l,
+		Cols:      tnColsPerGrid,
+		Rows:      tnRowsPerGrid,
+		Sheets:    tnMaxGridIndex,
+		Digits:    2,
+		Separator: " ",
+	}
+}
+
 // ColRowToTribeNetCoord converts a col, row value to a TribeNet coordinate string ("AB 0102").
 //
 // The conversion is based on:
@@ -52,19 +65,7 @@ type TribeNetLayout struct {
 //
 // Returns an error if the coordinate falls outside the supported 26×26 letter grid.
 func (l TribeNetLayout) ColRowToTribeNetCoord(col, row int) (string, error) {
-	if col < 0 || row < 0 {
-		return "", fmt.Errorf("invalid col, row: %d, %d", col, row)
-	}
-
-	gridRow, gridCol := row/tnRowsPerGrid, col/tnColsPerGrid
-	if gridRow >= tnMaxGridIndex || gridCol >= tnMaxGridIndex {
-		return "", fmt.Errorf("coordinates out of range for A-Z grid system")
-	}
-	gridRowChar, gridColChar := 'A'+rune(gridRow), 'A'+rune(gridCol)
-	subCol, subRow := (col % tnColsPerGrid), (row % tnRowsPerGrid)
-
-	// be sure to translate the coordinates by (+1,+1) to shift the origin back
-	return fmt.Sprintf("%c%c %02d%02d", gridRowChar, gridColChar, subCol+1, subRow+1), nil
+	return l.sheetScheme().FormatColRow(col, row)
 }
 
 // DirectionToBearing overrides the default to use the TribeNet bearings.
@@ -73,8 +74,7 @@ func (l TribeNetLayout) DirectionToBearing(direction int) string {
 }
 
 func (l TribeNetLayout) HexToTribeNetCoord(h Hex) (string, error) {
-	oc := l.HexToOffsetCoord(h)
-	return l.ColRowToTribeNetCoord(oc.Col, oc.Row)
+	return l.sheetScheme().Format(h)
 }
 
 // TribeNetCoordToColRow parses a Tribenet coordinate (eg, "AB 0102") and returns
@@ -87,44 +87,14 @@ func (l TribeNetLayout) HexToTribeNetCoord(h Hex) (string, error) {
 // TribeNet coordinate "JK 0609" corresponds to (163,104).
 // TribeNet coordinate "ZZ 3021" corresponds to (779,545).
 func (l TribeNetLayout) TribeNetCoordToColRow(input string) (col, row int, err error) {
-	if len(input) != 7 || input[2] != ' ' {
-		return 0, 0, fmt.Errorf("invalid format: expected 'AB 0102'")
-	}
-	gridRowRune, gridColRune := rune(input[0]), rune(input[1])
-	subColStr, subRowStr := input[3:5], input[5:7]
-
-	// convert letters to 0-based grid index (A=0, B=1, ...)
-	if !(unicode.IsUpper(gridRowRune) && unicode.IsLetter(gridRowRune)) {
-		return 0, 0, fmt.Errorf("invalid grid row: must be uppercase A-Z")
-	} else if !(unicode.IsUpper(gridColRune) && unicode.IsLetter(gridColRune)) {
-		return 0, 0, fmt.Errorf("invalid grid column: must be uppercase A-Z")
-	}
-	gridRowOffset := int(gridRowRune-'A') * tnRowsPerGrid
-	gridColOffset := int(gridColRune-'A') * tnColsPerGrid
-
-	subCol, err := strconv.Atoi(subColStr)
-	if !(err == nil && 1 <= subCol && subCol <= tnColsPerGrid) {
-		return 0, 0, fmt.Errorf("invalid sub-map column: %s", subColStr)
-	}
-
-	subRow, err := strconv.Atoi(subRowStr)
-	if !(err == nil && 1 <= subRow && subRow <= tnRowsPerGrid) {
-		return 0, 0, fmt.Errorf("invalid sub-map row: %s", subRowStr)
-	}
-
-	// convert the coordinates to the grid and then translate by (-1,-1) to shift the origin
-	return gridColOffset + subCol - 1, gridRowOffset + subRow - 1, nil
+	return l.sheetScheme().ParseColRow(input)
 }
 
 // TribeNetCoordToHex converts the TribeNet coordinates to Hex.
 //
 // Invalid inputs will return an error.
 func (l TribeNetLayout) TribeNetCoordToHex(input string) (Hex, error) {
-	col, row, err := l.TribeNetCoordToColRow(input)
-	if err != nil {
-		return Hex{}, err
-	}
-	return l.OffsetColRowToHex(col, row), nil
+	return l.sheetScheme().Parse(input)
 }
 
 // define convenient names for directions on a TribeNet grid.
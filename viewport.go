@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"math"
+	"slices"
+)
+
+// Viewport is a camera over a layout.
+//
+// There are three coordinate spaces:
+//   - screen coordinates are pixels in the window, with (0,0) at the upper-left.
+//   - world coordinates are the pixels returned by the layout's HexToPixel.
+//   - hex coordinates are the cube coordinates of the hexes.
+//
+// Pan is the world coordinate that is drawn at the upper-left corner of
+// the screen and zoom is the number of screen pixels per world pixel.
+type Viewport struct {
+	layout Layout_i
+
+	// width and height of the screen in pixels
+	width, height float64
+
+	pan  Point
+	zoom float64
+}
+
+// NewViewport returns a viewport for a screen of the given width and height.
+// The viewport starts with no pan and a zoom of 1.
+func NewViewport(l Layout_i, width, height float64) *Viewport {
+	return &Viewport{
+		layout: l,
+		width:  width,
+		height: height,
+		zoom:   1,
+	}
+}
+
+// Layout returns the layout used by the viewport.
+func (v *Viewport) Layout() Layout_i {
+	return v.layout
+}
+
+// Size returns the width and height of the screen in pixels.
+func (v *Viewport) Size() (width, height float64) {
+	return v.width, v.height
+}
+
+// Resize changes the width and height of the screen.
+// The pan is not changed, so the upper-left corner stays in place.
+func (v *Viewport) Resize(width, height float64) {
+	v.width, v.height = width, height
+}
+
+// Pan returns the world coordinate at the upper-left corner of the screen.
+func (v *Viewport) Pan() Point {
+	return v.pan
+}
+
+// SetPan moves the viewport so that the world coordinate is at the upper-left corner of the screen.
+func (v *Viewport) SetPan(p Point) {
+	v.pan = p
+}
+
+// PanBy moves the viewport by dx, dy screen pixels.
+func (v *Viewport) PanBy(dx, dy float64) {
+	v.pan.X += dx / v.zoom
+	v.pan.Y += dy / v.zoom
+}
+
+// CenterOn pans the viewport so that the hex is in the center of the screen.
+func (v *Viewport) CenterOn(h Hex) {
+	center := v.layout.HexToPixel(h)
+	v.pan = Point{X: center.X - v.width/2/v.zoom, Y: center.Y - v.height/2/v.zoom}
+}
+
+// Zoom returns the number of screen pixels per world pixel.
+func (v *Viewport) Zoom() float64 {
+	return v.zoom
+}
+
+// SetZoom changes the zoom while keeping the screen point at the same world coordinate.
+// This is what you want when zooming with the mouse wheel.
+// Zoom must be greater than zero; other values are ignored.
+func (v *Viewport) SetZoom(zoom float64, anchor Point) {
+	if !(zoom > 0) || math.IsInf(zoom, 0) {
+		return
+	}
+	world := v.ScreenToWorld(anchor)
+	v.zoom = zoom
+	v.pan = Point{X: world.X - anchor.X/zoom, Y: world.Y - anchor.Y/zoom}
+}
+
+// ZoomBy multiplies the zoom by the factor, keeping the screen point at the same world coordinate.
+func (v *Viewport) ZoomBy(factor float64, anchor Point) {
+	v.SetZoom(v.zoom*factor, anchor)
+}
+
+// ScreenToWorld converts a screen coordinate to a world coordinate.
+func (v *Viewport) ScreenToWorld(p Point) Point {
+	return Point{X: p.X/v.zoom + v.pan.X, Y: p.Y/v.zoom + v.pan.Y}
+}
+
+// WorldToScreen converts a world coordinate to a screen coordinate.
+func (v *Viewport) WorldToScreen(p Point) Point {
+	return Point{X: (p.X - v.pan.X) * v.zoom, Y: (p.Y - v.pan.Y) * v.zoom}
+}
+
+// ScreenToHex returns the hex under the screen coordinate.
+func (v *Viewport) ScreenToHex(p Point) Hex {
+	return v.layout.PixelToHexRounded(v.ScreenToWorld(p))
+}
+
+// HexToScreen returns the screen coordinate of the center of the hex.
+func (v *Viewport) HexToScreen(h Hex) Point {
+	return v.WorldToScreen(v.layout.HexToPixel(h))
+}
+
+// VisibleHexes returns the hexes that intersect the screen.
+// Margin is in screen pixels and grows the screen on every side;
+// use it to pre-load hexes that are just off the screen.
+func (v *Viewport) VisibleHexes(margin float64) []Hex {
+	return v.HexesInRect(Point{X: 0, Y: 0}, Point{X: v.width, Y: v.height}, margin)
+}
+
+// HexesInRect returns the hexes that intersect a rectangle given in screen coordinates.
+// Margin is in screen pixels and grows the rectangle on every side.
+//
+// A hex is included if its bounding box intersects the rectangle, so a few
+// hexes that only touch the rectangle with a corner of the bounding box may
+// be included. The hexes are sorted by offset row and then by offset column.
+func (v *Viewport) HexesInRect(min, max Point, margin float64) []Hex {
+	min = v.ScreenToWorld(Point{X: min.X - margin, Y: min.Y - margin})
+	max = v.ScreenToWorld(Point{X: max.X + margin, Y: max.Y + margin})
+	return HexesInWorldRect(v.layout, min, max)
+}
+
+// HexesInWorldRect returns the hexes whose bounding box intersects a rectangle in world coordinates.
+// The hexes are sorted by offset row and then by offset column.
+//
+// The cost is proportional to the number of hexes in the rectangle, not to
+// the number of pixels or the size of the map.
+func HexesInWorldRect(l Layout_i, min, max Point) []Hex {
+	if min.X > max.X {
+		min.X, max.X = max.X, min.X
+	}
+	if min.Y > max.Y {
+		min.Y, max.Y = max.Y, min.Y
+	}
+
+	// any hex that intersects the rectangle has its center in the rectangle
+	// after it has been grown by the half-width and half-height of a hex.
+	extent := hexExtent(l)
+	min = Point{X: min.X - extent.X, Y: min.Y - extent.Y}
+	max = Point{X: max.X + extent.X, Y: max.Y + extent.Y}
+
+	// q and r are linear in x and y, so their extremes are at the corners of the rectangle.
+	qMin, rMin := math.Inf(1), math.Inf(1)
+	qMax, rMax := math.Inf(-1), math.Inf(-1)
+	for _, p := range [4]Point{min, {X: max.X, Y: min.Y}, {X: min.X, Y: max.Y}, max} {
+		fh := l.PixelToFractionalHex(p)
+		qMin, qMax = math.Min(qMin, fh.q), math.Max(qMax, fh.q)
+		rMin, rMax = math.Min(rMin, fh.r), math.Max(rMax, fh.r)
+	}
+
+	var hexes []Hex
+	for r := int(math.Floor(rMin)); r <= int(math.Ceil(rMax)); r++ {
+		for q := int(math.Floor(qMin)); q <= int(math.Ceil(qMax)); q++ {
+			h := NewHexFromAxialCoords(q, r)
+			center := l.HexToPixel(h)
+			if min.X <= center.X && center.X <= max.X && min.Y <= center.Y && center.Y <= max.Y {
+				hexes = append(hexes, h)
+			}
+		}
+	}
+
+	slices.SortFunc(hexes, func(a, b Hex) int {
+		ao, bo := l.HexToOffsetCoord(a), l.HexToOffsetCoord(b)
+		if ao.Row != bo.Row {
+			return ao.Row - bo.Row
+		}
+		return ao.Col - bo.Col
+	})
+	return hexes
+}
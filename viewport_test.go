@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestViewport_Coordinates(t *testing.T) {
+	l := hexg.NewVerticalOddQLayout(hexg.NewPoint(10, 10), hexg.NewPoint(0, 0))
+	v := hexg.NewViewport(l, 800, 600)
+
+	v.SetPan(hexg.NewPoint(100, 50))
+	v.SetZoom(2, hexg.NewPoint(0, 0))
+	for _, tc := range []struct {
+		id     int
+		screen hexg.Point
+		world  hexg.Point
+	}{
+		{id: 1, screen: hexg.NewPoint(0, 0), world: hexg.NewPoint(100, 50)},
+		{id: 2, screen: hexg.NewPoint(200, 100), world: hexg.NewPoint(200, 100)},
+		{id: 3, screen: hexg.NewPoint(-20, 40), world: hexg.NewPoint(90, 70)},
+	} {
+		if got := v.ScreenToWorld(tc.screen); !closeTo(got, tc.world) {
+			t.Errorf("%d: screen %v: world: got %v, want %v\n", tc.id, tc.screen, got, tc.world)
+		}
+		if got := v.WorldToScreen(tc.world); !closeTo(got, tc.screen) {
+			t.Errorf("%d: world %v: screen: got %v, want %v\n", tc.id, tc.world, got, tc.screen)
+		}
+	}
+
+	// zooming about an anchor must keep the anchor fixed
+	anchor := hexg.NewPoint(400, 300)
+	before := v.ScreenToWorld(anchor)
+	v.ZoomBy(1.5, anchor)
+	if after := v.ScreenToWorld(anchor); !closeTo(before, after) {
+		t.Errorf("zoom: anchor: got %v, want %v\n", after, before)
+	}
+
+	// panning by screen pixels is scaled by the zoom
+	v.SetPan(hexg.NewPoint(0, 0))
+	v.SetZoom(4, hexg.NewPoint(0, 0))
+	v.PanBy(40, -80)
+	if got, want := v.Pan(), hexg.NewPoint(10, -20); !closeTo(got, want) {
+		t.Errorf("pan: got %v, want %v\n", got, want)
+	}
+
+	h := hexg.NewHex(3, -1, -2)
+	v.CenterOn(h)
+	if got := v.ScreenToHex(hexg.NewPoint(400, 300)); got != h {
+		t.Errorf("center: got %q, want %q\n", got.ConciseString(), h.ConciseString())
+	}
+	if got := v.HexToScreen(h); !closeTo(got, hexg.NewPoint(400, 300)) {
+		t.Errorf("center: screen: got %v, want %v\n", got, hexg.NewPoint(400, 300))
+	}
+}
+
+func TestViewport_VisibleHexes(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		l      hexg.Layout_i
+		pan    hexg.Point
+		zoom   float64
+		margin float64
+	}{
+		{id: 1, l: hexg.NewVerticalOddQLayout(hexg.NewPoint(10, 10), hexg.NewPoint(0, 0)), pan: hexg.NewPoint(0, 0), zoom: 1},
+		{id: 2, l: hexg.NewVerticalEvenQLayout(hexg.NewPoint(12, 8), hexg.NewPoint(5, 7)), pan: hexg.NewPoint(-37, 91), zoom: 0.5},
+		{id: 3, l: hexg.NewTribeNetLayout(), pan: hexg.NewPoint(-3.3, -2.1), zoom: 40, margin: 25},
+	} {
+		v := hexg.NewViewport(tc.l, 320, 200)
+		v.SetPan(tc.pan)
+		v.SetZoom(tc.zoom, hexg.NewPoint(0, 0))
+
+		got := map[hexg.Hex]bool{}
+		for _, h := range v.VisibleHexes(tc.margin) {
+			if got[h] {
+				t.Errorf("%d: visible: duplicate %q\n", tc.id, h.ConciseString())
+			}
+			got[h] = true
+		}
+
+		// brute force every hex around the screen and compare bounding boxes
+		min := v.ScreenToWorld(hexg.NewPoint(-tc.margin, -tc.margin))
+		max := v.ScreenToWorld(hexg.NewPoint(320+tc.margin, 200+tc.margin))
+		center := tc.l.PixelToHexRounded(v.ScreenToWorld(hexg.NewPoint(160, 100)))
+		for _, h := range tc.l.HexagonalGrid(center, 60) {
+			bbMin, bbMax := tc.l.HexCorner(h, 0), tc.l.HexCorner(h, 0)
+			for i := 0; i < 6; i++ {
+				c := tc.l.HexCorner(h, i)
+				bbMin.X, bbMin.Y = math.Min(bbMin.X, c.X), math.Min(bbMin.Y, c.Y)
+				bbMax.X, bbMax.Y = math.Max(bbMax.X, c.X), math.Max(bbMax.Y, c.Y)
+			}
+			// hexes that just touch the edge of the screen may go either way
+			touching := math.Abs(bbMin.X-max.X) < 1e-6 || math.Abs(bbMax.X-min.X) < 1e-6 || math.Abs(bbMin.Y-max.Y) < 1e-6 || math.Abs(bbMax.Y-min.Y) < 1e-6
+			want := bbMin.X <= max.X && min.X <= bbMax.X && bbMin.Y <= max.Y && min.Y <= bbMax.Y
+			if want != got[h] && !touching {
+				t.Errorf("%d: visible %q: got %v, want %v\n", tc.id, h.ConciseString(), got[h], want)
+			}
+		}
+		if len(got) == 0 {
+			t.Errorf("%d: visible: got no hexes\n", tc.id)
+		}
+	}
+}
+
+// closeTo returns true if the two points are within a small tolerance.
+func closeTo(a, b hexg.Point) bool {
+	return math.Abs(a.X-b.X) < 1e-9 && math.Abs(a.Y-b.Y) < 1e-9
+}
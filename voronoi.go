@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg
+
+import (
+	"math"
+	"slices"
+)
+
+// CostFunc returns the cost of moving from a hex to its neighbor.
+// Return a negative value or +Inf when the move is not allowed.
+type CostFunc func(from, to Hex) float64
+
+// Claim is the region that owns a hex.
+type Claim struct {
+	// Seed is the index of the seed that owns the hex.
+	Seed int
+
+	// Distance is the distance from the seed to the hex.
+	Distance float64
+}
+
+// Partition is the result of dividing a grid into regions around seed hexes.
+type Partition struct {
+	// Seeds are the seed hexes, in the order given to Voronoi.
+	Seeds []Hex
+
+	// Claims has the claim for every hex that a seed can reach.
+	Claims Grid[Claim]
+}
+
+// Voronoi partitions the hexes in the store into regions around the seeds.
+//
+// If cost is nil, every hex is claimed by the seed with the smallest
+// Hex.Distance. Otherwise, distances are the cheapest path cost through the
+// store, found with a multi-source Dijkstra search, and hexes that no seed can
+// reach are not claimed.
+//
+// Ties go to the seed with the lowest index, so the same input always gives
+// the same partition. Seeds that are not in the store don't claim any hexes.
+func Voronoi(gs GridStore, seeds []Hex, cost CostFunc) Partition {
+	p := Partition{Seeds: seeds, Claims: Grid[Claim]{}}
+
+	// sources are the seeds that are in the store
+	var sources []int
+	for n, seed := range seeds {
+		if gs.Contains(seed) {
+			sources = append(sources, n)
+		}
+	}
+	if len(sources) == 0 {
+		return p
+	}
+
+	if cost == nil {
+		for _, h := range gs {
+			claim := Claim{Seed: -1}
+			for _, n := range sources {
+				if d := float64(h.Distance(seeds[n])); claim.Seed < 0 || d < claim.Distance {
+					claim = Claim{Seed: n, Distance: d}
+				}
+			}
+			p.Claims.Set(h, claim)
+		}
+		return p
+	}
+
+	p.Claims = multiSourceSearch(gs, seeds, sources, cost, 0)
+	return p
+}
+
+// multiSourceSearch returns the claim of the nearest source for every hex in
+// the store that a source can reach. Sources are indexes into seeds.
+//
+// If cost is nil, every step costs 1 and the search is breadth-first.
+// Otherwise it is a Dijkstra search. Hexes farther than maxRange are not
+// claimed; a maxRange of zero or less means there is no limit.
+// Ties go to the source with the lowest index.
+func multiSourceSearch(gs GridStore, seeds []Hex, sources []int, cost CostFunc, maxRange float64) Grid[Claim] {
+	claims := Grid[Claim]{}
+	inRange := func(d float64) bool {
+		return maxRange <= 0 || d <= maxRange
+	}
+
+	if cost == nil {
+		// every step costs the same, so the first claim is the nearest.
+		// seeding the queue in source order breaks ties by index.
+		var queue []Hex
+		for _, n := range sources {
+			if !claims.Contains(seeds[n]) {
+				claims.Set(seeds[n], Claim{Seed: n})
+				queue = append(queue, seeds[n])
+			}
+		}
+		for len(queue) > 0 {
+			h := queue[0]
+			queue = queue[1:]
+			claim, _ := claims.Get(h)
+			if !inRange(claim.Distance + 1) {
+				continue
+			}
+			for direction := 0; direction < 6; direction++ {
+				if neighbor := h.Neighbor(direction); gs.Contains(neighbor) && !claims.Contains(neighbor) {
+					claims.Set(neighbor, Claim{Seed: claim.Seed, Distance: claim.Distance + 1})
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+		return claims
+	}
+
+	pq := &hexQueue{}
+	for _, n := range sources {
+		pq.push(queueItem{hex: seeds[n], cost: 0, source: n})
+	}
+	for pq.Len() > 0 {
+		item := pq.pop()
+		if claims.Contains(item.hex) {
+			// the queue orders by cost and then by source, so the first claim wins
+			continue
+		}
+		claims.Set(item.hex, Claim{Seed: item.source, Distance: item.cost})
+		for direction := 0; direction < 6; direction++ {
+			neighbor := item.hex.Neighbor(direction)
+			if !gs.Contains(neighbor) || claims.Contains(neighbor) {
+				continue
+			}
+			step := cost(item.hex, neighbor)
+			if step < 0 || math.IsInf(step, 1) || math.IsNaN(step) || !inRange(item.cost+step) {
+				continue
+			}
+			pq.push(queueItem{hex: neighbor, cost: item.cost + step, source: item.source})
+		}
+	}
+	return claims
+}
+
+// Region returns the hexes claimed by the seed, sorted by r and then by q.
+func (p Partition) Region(seed int) []Hex {
+	var hexes []Hex
+	for _, cell := range p.Claims {
+		if cell.Value.Seed == seed {
+			hexes = append(hexes, cell.Hex)
+		}
+	}
+	slices.SortFunc(hexes, compareHexes)
+	return hexes
+}
+
+// Adjacency returns the regions that touch each region.
+// Two regions touch when a hex in one is a neighbor of a hex in the other.
+// The key is the seed index and the list of neighboring seeds is sorted.
+// Regions without neighbors are not in the map.
+func (p Partition) Adjacency() map[int][]int {
+	adjacency := map[int][]int{}
+	for _, cell := range p.Claims {
+		for direction := 0; direction < 6; direction++ {
+			neighbor, ok := p.Claims.Get(cell.Hex.Neighbor(direction))
+			if !ok || neighbor.Seed == cell.Value.Seed {
+				continue
+			}
+			if !slices.Contains(adjacency[cell.Value.Seed], neighbor.Seed) {
+				adjacency[cell.Value.Seed] = append(adjacency[cell.Value.Seed], neighbor.Seed)
+			}
+		}
+	}
+	for _, seeds := range adjacency {
+		slices.Sort(seeds)
+	}
+	return adjacency
+}
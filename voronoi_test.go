@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package hexg_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/maloquacious/hexg"
+)
+
+func TestVoronoi(t *testing.T) {
+	gs := hexg.HexagonalGrid(4)
+	seeds := []hexg.Hex{hexg.NewHex(-4, 0, 4), hexg.NewHex(0, 0, 0), hexg.NewHex(4, 0, -4)}
+
+	p := hexg.Voronoi(gs, seeds, nil)
+	if len(p.Claims) != len(gs) {
+		t.Fatalf("plain: len: got %d, want %d\n", len(p.Claims), len(gs))
+	}
+	for _, tc := range []struct {
+		id       int
+		hex      hexg.Hex
+		seed     int
+		distance float64
+	}{
+		{id: 1, hex: hexg.NewHex(-4, 0, 4), seed: 0, distance: 0},
+		{id: 2, hex: hexg.NewHex(-3, 0, 3), seed: 0, distance: 1},
+		// ties go to the lower seed
+		{id: 3, hex: hexg.NewHex(-2, 0, 2), seed: 0, distance: 2},
+		{id: 4, hex: hexg.NewHex(2, 0, -2), seed: 1, distance: 2},
+		{id: 5, hex: hexg.NewHex(3, 0, -3), seed: 2, distance: 1},
+		{id: 6, hex: hexg.NewHex(0, 4, -4), seed: 1, distance: 4},
+	} {
+		if got, ok := p.Claims.Get(tc.hex); !ok {
+			t.Errorf("%d: %v: not claimed\n", tc.id, tc.hex)
+		} else if got.Seed != tc.seed || got.Distance != tc.distance {
+			t.Errorf("%d: %v: got %+v, want {Seed:%d Distance:%g}\n", tc.id, tc.hex, got, tc.seed, tc.distance)
+		}
+	}
+
+	// every hex is claimed by exactly one region
+	total := 0
+	for n := range seeds {
+		total += len(p.Region(n))
+	}
+	if total != len(gs) {
+		t.Errorf("regions: got %d hexes, want %d\n", total, len(gs))
+	}
+
+	if got, want := p.Adjacency(), map[int][]int{0: {1}, 1: {0, 2}, 2: {1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("adjacency: got %v, want %v\n", got, want)
+	}
+
+	// with a uniform cost, the search agrees with Hex.Distance on a hexagon
+	uniform := hexg.Voronoi(gs, seeds, func(from, to hexg.Hex) float64 { return 1 })
+	if !reflect.DeepEqual(uniform.Claims, p.Claims) {
+		t.Errorf("uniform: claims differ from plain distance\n")
+	}
+}
+
+func TestVoronoi_Weighted(t *testing.T) {
+	// a row of seven hexes with rough ground at q=1 and q=2
+	row := hexg.Parallelogram(hexg.NewHex(0, 0, 0), hexg.AxesQR, 0, 6, 0, 0)
+	seeds := []hexg.Hex{hexg.NewHex(0, 0, 0), hexg.NewHex(6, 0, -6)}
+	rough := func(from, to hexg.Hex) float64 {
+		if to == hexg.NewHex(1, 0, -1) || to == hexg.NewHex(2, 0, -2) {
+			return 3
+		}
+		return 1
+	}
+
+	p := hexg.Voronoi(row, seeds, rough)
+	for _, tc := range []struct {
+		id       int
+		q        int
+		seed     int
+		distance float64
+	}{
+		{id: 1, q: 0, seed: 0, distance: 0},
+		{id: 2, q: 1, seed: 0, distance: 3},
+		// both seeds reach q=2 for 6, so the lower seed wins
+		{id: 3, q: 2, seed: 0, distance: 6},
+		{id: 4, q: 3, seed: 1, distance: 3},
+		{id: 5, q: 6, seed: 1, distance: 0},
+	} {
+		h := hexg.NewHexFromAxialCoords(tc.q, 0)
+		if got, ok := p.Claims.Get(h); !ok {
+			t.Errorf("%d: %v: not claimed\n", tc.id, h)
+		} else if got.Seed != tc.seed || got.Distance != tc.distance {
+			t.Errorf("%d: %v: got %+v, want {Seed:%d Distance:%g}\n", tc.id, h, got, tc.seed, tc.distance)
+		}
+	}
+
+	// a wall at q=2 leaves the far side unclaimed when there is only one seed
+	wall := func(from, to hexg.Hex) float64 {
+		if to == hexg.NewHex(2, 0, -2) {
+			return math.Inf(1)
+		}
+		return 1
+	}
+	p = hexg.Voronoi(row, seeds[:1], wall)
+	if got := len(p.Claims); got != 2 {
+		t.Errorf("wall: len: got %d, want %d\n", got, 2)
+	}
+
+	// seeds outside the grid don't claim anything, and duplicate seeds lose to the first
+	p = hexg.Voronoi(row, []hexg.Hex{hexg.NewHex(9, -9, 0), seeds[0], seeds[0]}, rough)
+	if len(p.Region(0)) != 0 || len(p.Region(2)) != 0 || len(p.Region(1)) != len(row) {
+		t.Errorf("seeds: got %d %d %d, want %d %d %d\n", len(p.Region(0)), len(p.Region(1)), len(p.Region(2)), 0, len(row), 0)
+	}
+}